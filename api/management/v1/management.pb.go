@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: v1/management.proto
+
+package hobommanagementpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDLQRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDLQRequest) Reset() {
+	*x = ListDLQRequest{}
+	mi := &file_v1_management_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDLQRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDLQRequest) ProtoMessage() {}
+
+func (x *ListDLQRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDLQRequest.ProtoReflect.Descriptor instead.
+func (*ListDLQRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDLQRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type ListDLQResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDLQResponse) Reset() {
+	*x = ListDLQResponse{}
+	mi := &file_v1_management_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDLQResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDLQResponse) ProtoMessage() {}
+
+func (x *ListDLQResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDLQResponse.ProtoReflect.Descriptor instead.
+func (*ListDLQResponse) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDLQResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type GetDLQRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQRequest) Reset() {
+	*x = GetDLQRequest{}
+	mi := &file_v1_management_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQRequest) ProtoMessage() {}
+
+func (x *GetDLQRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDLQRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DLQHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQHeader) Reset() {
+	*x = DLQHeader{}
+	mi := &file_v1_management_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQHeader) ProtoMessage() {}
+
+func (x *DLQHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQHeader.ProtoReflect.Descriptor instead.
+func (*DLQHeader) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DLQHeader) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DLQHeader) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type DLQEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the DLQ key, e.g. dlq:menu:event-abc.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// kafka_key is the Kafka key the event is replayed with.
+	KafkaKey string       `protobuf:"bytes,2,opt,name=kafka_key,json=kafkaKey,proto3" json:"kafka_key,omitempty"`
+	Topic    string       `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Headers  []*DLQHeader `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// payload_json is the payload of a JSON event.
+	PayloadJson string `protobuf:"bytes,5,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	// value is the payload of a protobuf or Avro event.
+	Value []byte `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// reason is why validation rejected the event.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// error is why publishing the event failed.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// failed_at is when the event was saved to the DLQ, unset for entries
+	// saved before it was recorded.
+	FailedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQEntry) Reset() {
+	*x = DLQEntry{}
+	mi := &file_v1_management_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQEntry) ProtoMessage() {}
+
+func (x *DLQEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQEntry.ProtoReflect.Descriptor instead.
+func (*DLQEntry) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DLQEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetKafkaKey() string {
+	if x != nil {
+		return x.KafkaKey
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetHeaders() []*DLQHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DLQEntry) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *DLQEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+type RetryDLQRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryDLQRequest) Reset() {
+	*x = RetryDLQRequest{}
+	mi := &file_v1_management_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDLQRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDLQRequest) ProtoMessage() {}
+
+func (x *RetryDLQRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDLQRequest.ProtoReflect.Descriptor instead.
+func (*RetryDLQRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RetryDLQRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RetryDLQResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryDLQResponse) Reset() {
+	*x = RetryDLQResponse{}
+	mi := &file_v1_management_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDLQResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDLQResponse) ProtoMessage() {}
+
+func (x *RetryDLQResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDLQResponse.ProtoReflect.Descriptor instead.
+func (*RetryDLQResponse) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{6}
+}
+
+type RetryDLQBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryDLQBatchRequest) Reset() {
+	*x = RetryDLQBatchRequest{}
+	mi := &file_v1_management_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDLQBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDLQBatchRequest) ProtoMessage() {}
+
+func (x *RetryDLQBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDLQBatchRequest.ProtoReflect.Descriptor instead.
+func (*RetryDLQBatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetryDLQBatchRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RetryDLQBatchResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Retried []string               `protobuf:"bytes,1,rep,name=retried,proto3" json:"retried,omitempty"`
+	// failed maps the keys that were not replayed to their error.
+	Failed        map[string]string `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryDLQBatchResponse) Reset() {
+	*x = RetryDLQBatchResponse{}
+	mi := &file_v1_management_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDLQBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDLQBatchResponse) ProtoMessage() {}
+
+func (x *RetryDLQBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDLQBatchResponse.ProtoReflect.Descriptor instead.
+func (*RetryDLQBatchResponse) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RetryDLQBatchResponse) GetRetried() []string {
+	if x != nil {
+		return x.Retried
+	}
+	return nil
+}
+
+func (x *RetryDLQBatchResponse) GetFailed() map[string]string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type DeleteDLQRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDLQRequest) Reset() {
+	*x = DeleteDLQRequest{}
+	mi := &file_v1_management_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDLQRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDLQRequest) ProtoMessage() {}
+
+func (x *DeleteDLQRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDLQRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDLQRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteDLQRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteDLQResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDLQResponse) Reset() {
+	*x = DeleteDLQResponse{}
+	mi := &file_v1_management_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDLQResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDLQResponse) ProtoMessage() {}
+
+func (x *DeleteDLQResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDLQResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDLQResponse) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{10}
+}
+
+type PurgeDLQRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQRequest) Reset() {
+	*x = PurgeDLQRequest{}
+	mi := &file_v1_management_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQRequest) ProtoMessage() {}
+
+func (x *PurgeDLQRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PurgeDLQRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type PurgeDLQResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       []string               `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQResponse) Reset() {
+	*x = PurgeDLQResponse{}
+	mi := &file_v1_management_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQResponse) ProtoMessage() {}
+
+func (x *PurgeDLQResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQResponse) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PurgeDLQResponse) GetDeleted() []string {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+type ListPollersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollersRequest) Reset() {
+	*x = ListPollersRequest{}
+	mi := &file_v1_management_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollersRequest) ProtoMessage() {}
+
+func (x *ListPollersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollersRequest.ProtoReflect.Descriptor instead.
+func (*ListPollersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{13}
+}
+
+type PollerStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// state is idle, polling, paused, restarting or stopped.
+	State             string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Cycles            int64                  `protobuf:"varint,3,opt,name=cycles,proto3" json:"cycles,omitempty"`
+	ItemsProcessed    int64                  `protobuf:"varint,4,opt,name=items_processed,json=itemsProcessed,proto3" json:"items_processed,omitempty"`
+	Panics            int64                  `protobuf:"varint,5,opt,name=panics,proto3" json:"panics,omitempty"`
+	ConsecutivePanics int32                  `protobuf:"varint,6,opt,name=consecutive_panics,json=consecutivePanics,proto3" json:"consecutive_panics,omitempty"`
+	Restarts          int64                  `protobuf:"varint,7,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastRunAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastCycleAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_cycle_at,json=lastCycleAt,proto3" json:"last_cycle_at,omitempty"`
+	LastError         string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastPanic         string                 `protobuf:"bytes,11,opt,name=last_panic,json=lastPanic,proto3" json:"last_panic,omitempty"`
+	LastPanicAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_panic_at,json=lastPanicAt,proto3" json:"last_panic_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PollerStatus) Reset() {
+	*x = PollerStatus{}
+	mi := &file_v1_management_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollerStatus) ProtoMessage() {}
+
+func (x *PollerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollerStatus.ProtoReflect.Descriptor instead.
+func (*PollerStatus) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PollerStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PollerStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PollerStatus) GetCycles() int64 {
+	if x != nil {
+		return x.Cycles
+	}
+	return 0
+}
+
+func (x *PollerStatus) GetItemsProcessed() int64 {
+	if x != nil {
+		return x.ItemsProcessed
+	}
+	return 0
+}
+
+func (x *PollerStatus) GetPanics() int64 {
+	if x != nil {
+		return x.Panics
+	}
+	return 0
+}
+
+func (x *PollerStatus) GetConsecutivePanics() int32 {
+	if x != nil {
+		return x.ConsecutivePanics
+	}
+	return 0
+}
+
+func (x *PollerStatus) GetRestarts() int64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *PollerStatus) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *PollerStatus) GetLastCycleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCycleAt
+	}
+	return nil
+}
+
+func (x *PollerStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *PollerStatus) GetLastPanic() string {
+	if x != nil {
+		return x.LastPanic
+	}
+	return ""
+}
+
+func (x *PollerStatus) GetLastPanicAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPanicAt
+	}
+	return nil
+}
+
+type ListPollersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pollers       []*PollerStatus        `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollersResponse) Reset() {
+	*x = ListPollersResponse{}
+	mi := &file_v1_management_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollersResponse) ProtoMessage() {}
+
+func (x *ListPollersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_management_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollersResponse.ProtoReflect.Descriptor instead.
+func (*ListPollersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_management_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListPollersResponse) GetPollers() []*PollerStatus {
+	if x != nil {
+		return x.Pollers
+	}
+	return nil
+}
+
+var File_v1_management_proto protoreflect.FileDescriptor
+
+const file_v1_management_proto_rawDesc = "" +
+	"\n" +
+	"\x13v1/management.proto\x12\x13hobom.management.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"(\n" +
+	"\x0eListDLQRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"%\n" +
+	"\x0fListDLQResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"!\n" +
+	"\rGetDLQRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"3\n" +
+	"\tDLQHeader\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xa9\x02\n" +
+	"\bDLQEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\tkafka_key\x18\x02 \x01(\tR\bkafkaKey\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\x128\n" +
+	"\aheaders\x18\x04 \x03(\v2\x1e.hobom.management.v1.DLQHeaderR\aheaders\x12!\n" +
+	"\fpayload_json\x18\x05 \x01(\tR\vpayloadJson\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\fR\x05value\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x127\n" +
+	"\tfailed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"#\n" +
+	"\x0fRetryDLQRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x12\n" +
+	"\x10RetryDLQResponse\"*\n" +
+	"\x14RetryDLQBatchRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"\xbc\x01\n" +
+	"\x15RetryDLQBatchResponse\x12\x18\n" +
+	"\aretried\x18\x01 \x03(\tR\aretried\x12N\n" +
+	"\x06failed\x18\x02 \x03(\v26.hobom.management.v1.RetryDLQBatchResponse.FailedEntryR\x06failed\x1a9\n" +
+	"\vFailedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"$\n" +
+	"\x10DeleteDLQRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x13\n" +
+	"\x11DeleteDLQResponse\")\n" +
+	"\x0fPurgeDLQRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\",\n" +
+	"\x10PurgeDLQResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x03(\tR\adeleted\"\x14\n" +
+	"\x12ListPollersRequest\"\xd6\x03\n" +
+	"\fPollerStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x16\n" +
+	"\x06cycles\x18\x03 \x01(\x03R\x06cycles\x12'\n" +
+	"\x0fitems_processed\x18\x04 \x01(\x03R\x0eitemsProcessed\x12\x16\n" +
+	"\x06panics\x18\x05 \x01(\x03R\x06panics\x12-\n" +
+	"\x12consecutive_panics\x18\x06 \x01(\x05R\x11consecutivePanics\x12\x1a\n" +
+	"\brestarts\x18\a \x01(\x03R\brestarts\x12:\n" +
+	"\vlast_run_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\x12>\n" +
+	"\rlast_cycle_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vlastCycleAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"last_panic\x18\v \x01(\tR\tlastPanic\x12>\n" +
+	"\rlast_panic_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vlastPanicAt\"R\n" +
+	"\x13ListPollersResponse\x12;\n" +
+	"\apollers\x18\x01 \x03(\v2!.hobom.management.v1.PollerStatusR\apollers2\x8e\x05\n" +
+	"\x11ManagementService\x12T\n" +
+	"\aListDLQ\x12#.hobom.management.v1.ListDLQRequest\x1a$.hobom.management.v1.ListDLQResponse\x12K\n" +
+	"\x06GetDLQ\x12\".hobom.management.v1.GetDLQRequest\x1a\x1d.hobom.management.v1.DLQEntry\x12W\n" +
+	"\bRetryDLQ\x12$.hobom.management.v1.RetryDLQRequest\x1a%.hobom.management.v1.RetryDLQResponse\x12f\n" +
+	"\rRetryDLQBatch\x12).hobom.management.v1.RetryDLQBatchRequest\x1a*.hobom.management.v1.RetryDLQBatchResponse\x12Z\n" +
+	"\tDeleteDLQ\x12%.hobom.management.v1.DeleteDLQRequest\x1a&.hobom.management.v1.DeleteDLQResponse\x12W\n" +
+	"\bPurgeDLQ\x12$.hobom.management.v1.PurgeDLQRequest\x1a%.hobom.management.v1.PurgeDLQResponse\x12`\n" +
+	"\vListPollers\x12'.hobom.management.v1.ListPollersRequest\x1a(.hobom.management.v1.ListPollersResponseBNZLgithub.com/HoBom-s/hobom-event-processor/api/management/v1;hobommanagementpbb\x06proto3"
+
+var (
+	file_v1_management_proto_rawDescOnce sync.Once
+	file_v1_management_proto_rawDescData []byte
+)
+
+func file_v1_management_proto_rawDescGZIP() []byte {
+	file_v1_management_proto_rawDescOnce.Do(func() {
+		file_v1_management_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_management_proto_rawDesc), len(file_v1_management_proto_rawDesc)))
+	})
+	return file_v1_management_proto_rawDescData
+}
+
+var file_v1_management_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_v1_management_proto_goTypes = []any{
+	(*ListDLQRequest)(nil),        // 0: hobom.management.v1.ListDLQRequest
+	(*ListDLQResponse)(nil),       // 1: hobom.management.v1.ListDLQResponse
+	(*GetDLQRequest)(nil),         // 2: hobom.management.v1.GetDLQRequest
+	(*DLQHeader)(nil),             // 3: hobom.management.v1.DLQHeader
+	(*DLQEntry)(nil),              // 4: hobom.management.v1.DLQEntry
+	(*RetryDLQRequest)(nil),       // 5: hobom.management.v1.RetryDLQRequest
+	(*RetryDLQResponse)(nil),      // 6: hobom.management.v1.RetryDLQResponse
+	(*RetryDLQBatchRequest)(nil),  // 7: hobom.management.v1.RetryDLQBatchRequest
+	(*RetryDLQBatchResponse)(nil), // 8: hobom.management.v1.RetryDLQBatchResponse
+	(*DeleteDLQRequest)(nil),      // 9: hobom.management.v1.DeleteDLQRequest
+	(*DeleteDLQResponse)(nil),     // 10: hobom.management.v1.DeleteDLQResponse
+	(*PurgeDLQRequest)(nil),       // 11: hobom.management.v1.PurgeDLQRequest
+	(*PurgeDLQResponse)(nil),      // 12: hobom.management.v1.PurgeDLQResponse
+	(*ListPollersRequest)(nil),    // 13: hobom.management.v1.ListPollersRequest
+	(*PollerStatus)(nil),          // 14: hobom.management.v1.PollerStatus
+	(*ListPollersResponse)(nil),   // 15: hobom.management.v1.ListPollersResponse
+	nil,                           // 16: hobom.management.v1.RetryDLQBatchResponse.FailedEntry
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+}
+var file_v1_management_proto_depIdxs = []int32{
+	3,  // 0: hobom.management.v1.DLQEntry.headers:type_name -> hobom.management.v1.DLQHeader
+	17, // 1: hobom.management.v1.DLQEntry.failed_at:type_name -> google.protobuf.Timestamp
+	16, // 2: hobom.management.v1.RetryDLQBatchResponse.failed:type_name -> hobom.management.v1.RetryDLQBatchResponse.FailedEntry
+	17, // 3: hobom.management.v1.PollerStatus.last_run_at:type_name -> google.protobuf.Timestamp
+	17, // 4: hobom.management.v1.PollerStatus.last_cycle_at:type_name -> google.protobuf.Timestamp
+	17, // 5: hobom.management.v1.PollerStatus.last_panic_at:type_name -> google.protobuf.Timestamp
+	14, // 6: hobom.management.v1.ListPollersResponse.pollers:type_name -> hobom.management.v1.PollerStatus
+	0,  // 7: hobom.management.v1.ManagementService.ListDLQ:input_type -> hobom.management.v1.ListDLQRequest
+	2,  // 8: hobom.management.v1.ManagementService.GetDLQ:input_type -> hobom.management.v1.GetDLQRequest
+	5,  // 9: hobom.management.v1.ManagementService.RetryDLQ:input_type -> hobom.management.v1.RetryDLQRequest
+	7,  // 10: hobom.management.v1.ManagementService.RetryDLQBatch:input_type -> hobom.management.v1.RetryDLQBatchRequest
+	9,  // 11: hobom.management.v1.ManagementService.DeleteDLQ:input_type -> hobom.management.v1.DeleteDLQRequest
+	11, // 12: hobom.management.v1.ManagementService.PurgeDLQ:input_type -> hobom.management.v1.PurgeDLQRequest
+	13, // 13: hobom.management.v1.ManagementService.ListPollers:input_type -> hobom.management.v1.ListPollersRequest
+	1,  // 14: hobom.management.v1.ManagementService.ListDLQ:output_type -> hobom.management.v1.ListDLQResponse
+	4,  // 15: hobom.management.v1.ManagementService.GetDLQ:output_type -> hobom.management.v1.DLQEntry
+	6,  // 16: hobom.management.v1.ManagementService.RetryDLQ:output_type -> hobom.management.v1.RetryDLQResponse
+	8,  // 17: hobom.management.v1.ManagementService.RetryDLQBatch:output_type -> hobom.management.v1.RetryDLQBatchResponse
+	10, // 18: hobom.management.v1.ManagementService.DeleteDLQ:output_type -> hobom.management.v1.DeleteDLQResponse
+	12, // 19: hobom.management.v1.ManagementService.PurgeDLQ:output_type -> hobom.management.v1.PurgeDLQResponse
+	15, // 20: hobom.management.v1.ManagementService.ListPollers:output_type -> hobom.management.v1.ListPollersResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_v1_management_proto_init() }
+func file_v1_management_proto_init() {
+	if File_v1_management_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_management_proto_rawDesc), len(file_v1_management_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_management_proto_goTypes,
+		DependencyIndexes: file_v1_management_proto_depIdxs,
+		MessageInfos:      file_v1_management_proto_msgTypes,
+	}.Build()
+	File_v1_management_proto = out.File
+	file_v1_management_proto_goTypes = nil
+	file_v1_management_proto_depIdxs = nil
+}
@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: v1/management.proto
+
+package hobommanagementpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ManagementService_ListDLQ_FullMethodName       = "/hobom.management.v1.ManagementService/ListDLQ"
+	ManagementService_GetDLQ_FullMethodName        = "/hobom.management.v1.ManagementService/GetDLQ"
+	ManagementService_RetryDLQ_FullMethodName      = "/hobom.management.v1.ManagementService/RetryDLQ"
+	ManagementService_RetryDLQBatch_FullMethodName = "/hobom.management.v1.ManagementService/RetryDLQBatch"
+	ManagementService_DeleteDLQ_FullMethodName     = "/hobom.management.v1.ManagementService/DeleteDLQ"
+	ManagementService_PurgeDLQ_FullMethodName      = "/hobom.management.v1.ManagementService/PurgeDLQ"
+	ManagementService_ListPollers_FullMethodName   = "/hobom.management.v1.ManagementService/ListPollers"
+)
+
+// ManagementServiceClient is the client API for ManagementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ManagementService mirrors the DLQ and poller routes of the HTTP management
+// API. Calls carry the same bearer credentials as HTTP requests in the
+// authorization metadata.
+type ManagementServiceClient interface {
+	// ListDLQ returns the DLQ keys starting with prefix, or every dlq:* key.
+	ListDLQ(ctx context.Context, in *ListDLQRequest, opts ...grpc.CallOption) (*ListDLQResponse, error)
+	// GetDLQ returns the entry stored under key.
+	GetDLQ(ctx context.Context, in *GetDLQRequest, opts ...grpc.CallOption) (*DLQEntry, error)
+	// RetryDLQ replays the entry stored under key, marks its outbox row SENT
+	// and deletes it.
+	RetryDLQ(ctx context.Context, in *RetryDLQRequest, opts ...grpc.CallOption) (*RetryDLQResponse, error)
+	// RetryDLQBatch replays several entries. Nothing is published if a key is
+	// missing, malformed or still invalid.
+	RetryDLQBatch(ctx context.Context, in *RetryDLQBatchRequest, opts ...grpc.CallOption) (*RetryDLQBatchResponse, error)
+	// DeleteDLQ deletes the entry stored under key without replaying it.
+	DeleteDLQ(ctx context.Context, in *DeleteDLQRequest, opts ...grpc.CallOption) (*DeleteDLQResponse, error)
+	// PurgeDLQ deletes every entry under prefix, which must start with dlq:.
+	PurgeDLQ(ctx context.Context, in *PurgeDLQRequest, opts ...grpc.CallOption) (*PurgeDLQResponse, error)
+	// ListPollers returns the status of every poller.
+	ListPollers(ctx context.Context, in *ListPollersRequest, opts ...grpc.CallOption) (*ListPollersResponse, error)
+}
+
+type managementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManagementServiceClient(cc grpc.ClientConnInterface) ManagementServiceClient {
+	return &managementServiceClient{cc}
+}
+
+func (c *managementServiceClient) ListDLQ(ctx context.Context, in *ListDLQRequest, opts ...grpc.CallOption) (*ListDLQResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDLQResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListDLQ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetDLQ(ctx context.Context, in *GetDLQRequest, opts ...grpc.CallOption) (*DLQEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DLQEntry)
+	err := c.cc.Invoke(ctx, ManagementService_GetDLQ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) RetryDLQ(ctx context.Context, in *RetryDLQRequest, opts ...grpc.CallOption) (*RetryDLQResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryDLQResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RetryDLQ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) RetryDLQBatch(ctx context.Context, in *RetryDLQBatchRequest, opts ...grpc.CallOption) (*RetryDLQBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryDLQBatchResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RetryDLQBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) DeleteDLQ(ctx context.Context, in *DeleteDLQRequest, opts ...grpc.CallOption) (*DeleteDLQResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDLQResponse)
+	err := c.cc.Invoke(ctx, ManagementService_DeleteDLQ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) PurgeDLQ(ctx context.Context, in *PurgeDLQRequest, opts ...grpc.CallOption) (*PurgeDLQResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeDLQResponse)
+	err := c.cc.Invoke(ctx, ManagementService_PurgeDLQ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListPollers(ctx context.Context, in *ListPollersRequest, opts ...grpc.CallOption) (*ListPollersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPollersResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListPollers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagementServiceServer is the server API for ManagementService service.
+// All implementations must embed UnimplementedManagementServiceServer
+// for forward compatibility.
+//
+// ManagementService mirrors the DLQ and poller routes of the HTTP management
+// API. Calls carry the same bearer credentials as HTTP requests in the
+// authorization metadata.
+type ManagementServiceServer interface {
+	// ListDLQ returns the DLQ keys starting with prefix, or every dlq:* key.
+	ListDLQ(context.Context, *ListDLQRequest) (*ListDLQResponse, error)
+	// GetDLQ returns the entry stored under key.
+	GetDLQ(context.Context, *GetDLQRequest) (*DLQEntry, error)
+	// RetryDLQ replays the entry stored under key, marks its outbox row SENT
+	// and deletes it.
+	RetryDLQ(context.Context, *RetryDLQRequest) (*RetryDLQResponse, error)
+	// RetryDLQBatch replays several entries. Nothing is published if a key is
+	// missing, malformed or still invalid.
+	RetryDLQBatch(context.Context, *RetryDLQBatchRequest) (*RetryDLQBatchResponse, error)
+	// DeleteDLQ deletes the entry stored under key without replaying it.
+	DeleteDLQ(context.Context, *DeleteDLQRequest) (*DeleteDLQResponse, error)
+	// PurgeDLQ deletes every entry under prefix, which must start with dlq:.
+	PurgeDLQ(context.Context, *PurgeDLQRequest) (*PurgeDLQResponse, error)
+	// ListPollers returns the status of every poller.
+	ListPollers(context.Context, *ListPollersRequest) (*ListPollersResponse, error)
+	mustEmbedUnimplementedManagementServiceServer()
+}
+
+// UnimplementedManagementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedManagementServiceServer struct{}
+
+func (UnimplementedManagementServiceServer) ListDLQ(context.Context, *ListDLQRequest) (*ListDLQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDLQ not implemented")
+}
+func (UnimplementedManagementServiceServer) GetDLQ(context.Context, *GetDLQRequest) (*DLQEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDLQ not implemented")
+}
+func (UnimplementedManagementServiceServer) RetryDLQ(context.Context, *RetryDLQRequest) (*RetryDLQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDLQ not implemented")
+}
+func (UnimplementedManagementServiceServer) RetryDLQBatch(context.Context, *RetryDLQBatchRequest) (*RetryDLQBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDLQBatch not implemented")
+}
+func (UnimplementedManagementServiceServer) DeleteDLQ(context.Context, *DeleteDLQRequest) (*DeleteDLQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDLQ not implemented")
+}
+func (UnimplementedManagementServiceServer) PurgeDLQ(context.Context, *PurgeDLQRequest) (*PurgeDLQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDLQ not implemented")
+}
+func (UnimplementedManagementServiceServer) ListPollers(context.Context, *ListPollersRequest) (*ListPollersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPollers not implemented")
+}
+func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
+func (UnimplementedManagementServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeManagementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManagementServiceServer will
+// result in compilation errors.
+type UnsafeManagementServiceServer interface {
+	mustEmbedUnimplementedManagementServiceServer()
+}
+
+func RegisterManagementServiceServer(s grpc.ServiceRegistrar, srv ManagementServiceServer) {
+	// If the following call pancis, it indicates UnimplementedManagementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ManagementService_ServiceDesc, srv)
+}
+
+func _ManagementService_ListDLQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDLQRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListDLQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListDLQ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListDLQ(ctx, req.(*ListDLQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetDLQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetDLQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetDLQ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetDLQ(ctx, req.(*GetDLQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RetryDLQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDLQRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RetryDLQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RetryDLQ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RetryDLQ(ctx, req.(*RetryDLQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RetryDLQBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDLQBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RetryDLQBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RetryDLQBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RetryDLQBatch(ctx, req.(*RetryDLQBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_DeleteDLQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDLQRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).DeleteDLQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_DeleteDLQ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).DeleteDLQ(ctx, req.(*DeleteDLQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_PurgeDLQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDLQRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).PurgeDLQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_PurgeDLQ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).PurgeDLQ(ctx, req.(*PurgeDLQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListPollers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPollersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListPollers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListPollers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListPollers(ctx, req.(*ListPollersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ManagementService_ServiceDesc is the grpc.ServiceDesc for ManagementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ManagementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hobom.management.v1.ManagementService",
+	HandlerType: (*ManagementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDLQ",
+			Handler:    _ManagementService_ListDLQ_Handler,
+		},
+		{
+			MethodName: "GetDLQ",
+			Handler:    _ManagementService_GetDLQ_Handler,
+		},
+		{
+			MethodName: "RetryDLQ",
+			Handler:    _ManagementService_RetryDLQ_Handler,
+		},
+		{
+			MethodName: "RetryDLQBatch",
+			Handler:    _ManagementService_RetryDLQBatch_Handler,
+		},
+		{
+			MethodName: "DeleteDLQ",
+			Handler:    _ManagementService_DeleteDLQ_Handler,
+		},
+		{
+			MethodName: "PurgeDLQ",
+			Handler:    _ManagementService_PurgeDLQ_Handler,
+		},
+		{
+			MethodName: "ListPollers",
+			Handler:    _ManagementService_ListPollers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/management.proto",
+}
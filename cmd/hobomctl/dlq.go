@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+// filter selects DLQ entries. The prefix is applied by the server, the
+// other fields to the fetched entries.
+type filter struct {
+	prefix string
+	topic  string
+	reason string
+}
+
+func (f *filter) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.prefix, "prefix", "", "only keys starting with the prefix, e.g. dlq:menu:")
+	fs.StringVar(&f.topic, "topic", "", "only entries of the Kafka topic")
+	fs.StringVar(&f.reason, "reason", "", "only entries whose reason contains the text (case-insensitive)")
+}
+
+// fetchesEntries reports whether the filter needs the entries and not only
+// their keys.
+func (f filter) fetchesEntries() bool {
+	return f.topic != "" || f.reason != ""
+}
+
+func (f filter) matches(e entry) bool {
+	if f.topic != "" && e.Metadata.Topic != f.topic {
+		return false
+	}
+	if f.reason != "" {
+		reason := ""
+		if e.Metadata.Reason != nil {
+			reason = *e.Metadata.Reason
+		}
+		if !strings.Contains(strings.ToLower(reason), strings.ToLower(f.reason)) {
+			return false
+		}
+	}
+	return true
+}
+
+// entry is a DLQ entry together with its key.
+type entry struct {
+	Key string `json:"key"`
+	client.DLQEntry
+}
+
+func (c *cli) dlq(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageError("missing dlq command, see hobomctl -h")
+	}
+	switch args[0] {
+	case "list":
+		return c.dlqList(ctx, args[1:])
+	case "inspect":
+		return c.dlqInspect(ctx, args[1:])
+	case "edit":
+		return c.dlqEdit(ctx, args[1:])
+	case "history":
+		return c.dlqHistory(ctx, args[1:])
+	case "retry":
+		return c.dlqRetry(ctx, args[1:])
+	case "delete":
+		return c.dlqDelete(ctx, args[1:])
+	case "purge":
+		return c.dlqPurge(ctx, args[1:])
+	case "search":
+		return c.dlqSearch(ctx, args[1:])
+	case "stats":
+		return c.dlqStats(ctx, args[1:])
+	case "export":
+		return c.dlqExport(ctx, args[1:])
+	case "import":
+		return c.dlqImport(ctx, args[1:])
+	default:
+		return usageError(fmt.Sprintf("unknown dlq command %q, see hobomctl -h", args[0]))
+	}
+}
+
+func (c *cli) dlqList(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq list", flag.ContinueOnError)
+	var f filter
+	f.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	entries, err := c.entries(ctx, f)
+	if err != nil {
+		return err
+	}
+	return c.print(entries, func(w io.Writer) {
+		fmt.Fprintln(w, "KEY\tTOPIC\tREASON")
+		for _, e := range entries {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", e.Key, e.Metadata.Topic, orDash(e.Metadata.Reason))
+		}
+	})
+}
+
+func (c *cli) dlqInspect(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return usageError("usage: hobomctl dlq inspect KEY")
+	}
+	e, err := c.entry(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return c.print(e, func(w io.Writer) {
+		fmt.Fprintf(w, "Key:\t%s\n", e.Key)
+		fmt.Fprintf(w, "Kafka key:\t%s\n", e.Metadata.Key)
+		fmt.Fprintf(w, "Topic:\t%s\n", e.Metadata.Topic)
+		fmt.Fprintf(w, "Reason:\t%s\n", orDash(e.Metadata.Reason))
+		if e.Metadata.Headers != nil {
+			for _, h := range *e.Metadata.Headers {
+				fmt.Fprintf(w, "Header:\t%s=%s\n", h.Key, h.Value)
+			}
+		}
+		item, _ := json.MarshalIndent(e.Item, "", "  ")
+		fmt.Fprintf(w, "Item:\n%s\n", item)
+	})
+}
+
+func (c *cli) dlqRetry(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq retry", flag.ContinueOnError)
+	var f filter
+	f.register(fs)
+	dryRun := fs.Bool("dry-run", false, "only list the keys that would be replayed")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	keys, err := c.selectKeys(ctx, f, fs.Args())
+	if err != nil {
+		return err
+	}
+	if *dryRun || len(keys) == 0 {
+		return c.printKeys(keys)
+	}
+
+	res, err := c.api.RetryDLQBatchWithResponse(ctx, client.RetryDLQBatchJSONRequestBody{Keys: keys})
+	if err != nil {
+		return err
+	}
+	result := res.JSON200
+	if res.JSON207 != nil {
+		result = res.JSON207
+	}
+	if result == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	if err := c.print(result, func(w io.Writer) {
+		fmt.Fprintln(w, "KEY\tRESULT")
+		for _, key := range result.Retried {
+			fmt.Fprintf(w, "%s\tretried\n", key)
+		}
+		for _, key := range slices.Sorted(maps.Keys(result.Failed)) {
+			fmt.Fprintf(w, "%s\tfailed: %s\n", key, result.Failed[key])
+		}
+	}); err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("%d of %d keys failed", len(result.Failed), len(keys))
+	}
+	return nil
+}
+
+func (c *cli) dlqDelete(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageError("usage: hobomctl dlq delete KEY...")
+	}
+	deleted, err := c.deleteKeys(ctx, args)
+	if printErr := c.printKeys(deleted); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+func (c *cli) dlqPurge(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq purge", flag.ContinueOnError)
+	var f filter
+	f.register(fs)
+	dryRun := fs.Bool("dry-run", false, "only list the keys that would be deleted")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+	// 실수로 모든 DLQ 를 제거하지 않도록 prefix 를 반드시 지정하도록 한다.
+	if !strings.HasPrefix(f.prefix, "dlq:") {
+		return usageError("dlq purge requires -prefix starting with dlq:")
+	}
+
+	if *dryRun || f.fetchesEntries() {
+		keys, err := c.selectKeys(ctx, f, nil)
+		if err != nil {
+			return err
+		}
+		if *dryRun {
+			return c.printKeys(keys)
+		}
+		deleted, err := c.deleteKeys(ctx, keys)
+		if printErr := c.printKeys(deleted); printErr != nil {
+			return printErr
+		}
+		return err
+	}
+
+	res, err := c.api.PurgeDLQWithResponse(ctx, &client.PurgeDLQParams{Prefix: f.prefix})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	return c.printKeys(res.JSON200.Deleted)
+}
+
+// entries returns the entries selected by f, in key order.
+func (c *cli) entries(ctx context.Context, f filter) ([]entry, error) {
+	keys, err := c.list(ctx, f.prefix)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]entry, 0, len(keys))
+	for _, key := range keys {
+		e, err := c.entry(ctx, key)
+		if err != nil {
+			var notFound notFoundError
+			if errors.As(err, &notFound) {
+				// 조회하는 사이 재발행되거나 만료된 Entry 는 건너뛴다.
+				continue
+			}
+			return nil, err
+		}
+		if f.matches(e) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// selectKeys returns keys when given, and the keys selected by f otherwise.
+func (c *cli) selectKeys(ctx context.Context, f filter, keys []string) ([]string, error) {
+	if len(keys) > 0 {
+		return keys, nil
+	}
+	if f.prefix == "" && !f.fetchesEntries() {
+		return nil, usageError("give keys or at least one of -prefix, -topic, -reason")
+	}
+	if !f.fetchesEntries() {
+		return c.list(ctx, f.prefix)
+	}
+	entries, err := c.entries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]string, len(entries))
+	for i, e := range entries {
+		selected[i] = e.Key
+	}
+	return selected, nil
+}
+
+func (c *cli) list(ctx context.Context, prefix string) ([]string, error) {
+	params := &client.ListDLQParams{}
+	if prefix != "" {
+		params.Prefix = &prefix
+	}
+	res, err := c.api.ListDLQWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	keys := res.JSON200.Items
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// notFoundError is a DLQ key that does not exist (anymore).
+type notFoundError struct{ key string }
+
+func (e notFoundError) Error() string { return fmt.Sprintf("DLQ entry %s not found", e.key) }
+
+func (c *cli) entry(ctx context.Context, key string) (entry, error) {
+	res, err := c.api.GetDLQWithResponse(ctx, key)
+	if err != nil {
+		return entry{}, err
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return entry{}, notFoundError{key: key}
+	}
+	if res.JSON200 == nil {
+		return entry{}, apiError(res.StatusCode(), res.Body)
+	}
+	return entry{Key: key, DLQEntry: *res.JSON200}, nil
+}
+
+// deleteKeys deletes every key and returns the deleted ones, stopping at
+// the first failure.
+func (c *cli) deleteKeys(ctx context.Context, keys []string) ([]string, error) {
+	deleted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		res, err := c.api.DeleteDLQWithResponse(ctx, key)
+		if err != nil {
+			return deleted, err
+		}
+		if res.JSON200 == nil {
+			return deleted, fmt.Errorf("failed to delete %s: %w", key, apiError(res.StatusCode(), res.Body))
+		}
+		deleted = append(deleted, key)
+	}
+	return deleted, nil
+}
+
+func (c *cli) printKeys(keys []string) error {
+	if keys == nil {
+		keys = []string{}
+	}
+	return c.print(keys, func(w io.Writer) {
+		for _, key := range keys {
+			fmt.Fprintln(w, key)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+func (c *cli) dlqEdit(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq edit", flag.ContinueOnError)
+	file := fs.String("f", "-", "file holding the new JSON payload, - for stdin")
+	replay := fs.Bool("replay", false, "replay the entry once edited")
+	version := fs.Int("version", -1, "fail unless the entry is at this version, e.g. the one seen by dlq history")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+	if fs.NArg() != 1 {
+		return usageError("usage: hobomctl dlq edit [-f FILE] [-replay] [-version N] KEY")
+	}
+	key := fs.Arg(0)
+
+	var in io.Reader = os.Stdin
+	if *file != "-" {
+		fh, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		in = fh
+	}
+	payload, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	if !json.Valid(payload) {
+		return errors.New("the payload is not valid JSON")
+	}
+
+	body := client.EditRequest{Payload: json.RawMessage(payload)}
+	if *version >= 0 {
+		body.Version = version
+	}
+	res, err := c.api.EditDLQWithResponse(ctx, key, &client.EditDLQParams{Replay: replay}, body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case res.JSON200 != nil:
+		result := res.JSON200
+		return c.print(result, func(w io.Writer) {
+			fmt.Fprintf(w, "%s edited to version %d", result.Key, result.Version)
+			if result.Replayed {
+				fmt.Fprint(w, " and replayed")
+			}
+			fmt.Fprintln(w)
+		})
+	case res.JSON500 != nil && res.JSON500.Error != nil:
+		// 수정은 저장되었으므로, 재발행만 다시 시도하면 된다.
+		result := res.JSON500
+		c.print(result, func(w io.Writer) {
+			fmt.Fprintf(w, "%s edited to version %d\n", result.Key, result.Version)
+		})
+		return fmt.Errorf("replay failed, retry with hobomctl dlq retry %s: %s", result.Key, *result.Error)
+	case res.JSON404 != nil:
+		return notFoundError{key: key}
+	default:
+		return apiError(res.StatusCode(), res.Body)
+	}
+}
+
+func (c *cli) dlqHistory(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return usageError("usage: hobomctl dlq history KEY")
+	}
+	res, err := c.api.GetDLQHistoryWithResponse(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if res.JSON404 != nil {
+		return notFoundError{key: args[0]}
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	history := res.JSON200
+	return c.print(history, func(w io.Writer) {
+		fmt.Fprintln(w, "VERSION\tEDITED AT\tEDITED BY\tFAILURE\tPAYLOAD")
+		for _, v := range history.Items {
+			payload := []byte("-")
+			if v.Payload != nil {
+				payload, _ = json.Marshal(*v.Payload)
+			}
+			failure := v.Reason
+			if orDash(v.Reason) == "-" {
+				failure = v.Error
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", v.Version, formatTime(v.EditedAt), orDash(v.EditedBy), orDash(failure), payload)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+// Command hobomctl operates the DLQ and pollers of hobom-event-processor
+// through its management API.
+//
+//	hobomctl [global flags] <command> [flags] [args]
+//
+// Run hobomctl -h for the commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+const usage = `Usage: hobomctl [global flags] <command> [flags] [args]
+
+DLQ:
+  dlq list      List DLQ entries matching the filters
+  dlq inspect   Show a DLQ entry
+  dlq search    Search the entries by recipient, trace ID, error and failure time
+  dlq stats     Count the matching entries by prefix, topic, reason and hour
+  dlq edit      Replace the payload of an entry, optionally replaying it
+  dlq history   Show the versions of an entry's payload
+  dlq retry     Replay the given keys, or the entries matching the filters
+  dlq delete    Delete the given keys without replaying them
+  dlq purge     Delete the entries matching the filters without replaying them
+  dlq export    Write the entries matching the filters as NDJSON
+  dlq import    Store the entries of an NDJSON export
+
+Pollers:
+  poller status             Show the state and counters of the poll loops
+  poller pause NAME         Stop scheduling cycles of a poller
+  poller resume NAME        Resume a paused poller
+  poller trigger NAME       Run one cycle as soon as the poller is free
+
+  tail          Follow new DLQ entries and, with -audit, management actions
+
+Global flags:
+`
+
+// cli runs a command against the management API.
+type cli struct {
+	api    *client.ClientWithResponses
+	out    io.Writer
+	format string
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run parses the global flags and runs the command, returning the exit code.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("hobomctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	server := fs.String("server", envOr("HOBOMCTL_SERVER", "http://localhost:8082"), "management API base URL (HOBOMCTL_SERVER)")
+	token := fs.String("token", os.Getenv("HOBOMCTL_TOKEN"), "bearer token or JWT (HOBOMCTL_TOKEN)")
+	keyId := fs.String("hmac-key-id", os.Getenv("HOBOMCTL_HMAC_KEY_ID"), "HMAC key ID to sign requests with (HOBOMCTL_HMAC_KEY_ID)")
+	secret := fs.String("hmac-secret", os.Getenv("HOBOMCTL_HMAC_SECRET"), "HMAC secret (HOBOMCTL_HMAC_SECRET)")
+	format := fs.String("o", "table", "output format: table or json")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *format != "table" && *format != "json" {
+		fmt.Fprintf(stderr, "hobomctl: unknown output format %q\n", *format)
+		return 2
+	}
+
+	var opts []client.ClientOption
+	switch {
+	case *keyId != "":
+		opts = append(opts, client.WithHMACKey(*keyId, *secret))
+	case *token != "":
+		opts = append(opts, client.WithBearerToken(*token))
+	}
+	api, err := client.NewClientWithResponses(*server, opts...)
+	if err != nil {
+		fmt.Fprintf(stderr, "hobomctl: %v\n", err)
+		return 1
+	}
+
+	c := &cli{api: api, out: stdout, format: *format}
+	if err := c.dispatch(ctx, fs.Args()); err != nil {
+		fmt.Fprintf(stderr, "hobomctl: %v\n", err)
+		if errors.As(err, new(usageError)) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// usageError is a command line that names no known command or lacks
+// arguments.
+type usageError string
+
+func (e usageError) Error() string { return string(e) }
+
+func (c *cli) dispatch(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageError("missing command, see hobomctl -h")
+	}
+	switch args[0] {
+	case "dlq":
+		return c.dlq(ctx, args[1:])
+	case "poller":
+		return c.poller(ctx, args[1:])
+	case "tail":
+		return c.tail(ctx, args[1:])
+	default:
+		return usageError(fmt.Sprintf("unknown command %q, see hobomctl -h", args[0]))
+	}
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
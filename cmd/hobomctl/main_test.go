@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	redisClient "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/dlq"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+	"github.com/gin-gonic/gin"
+)
+
+const prefix = "/hobom-event-processor/internal/api/v1"
+
+// --- Test doubles ---
+
+type memoryDLQStore struct {
+	mu   sync.Mutex
+	data map[string][]byte
+	ttls map[string]time.Duration
+}
+
+func newMemoryDLQStore() *memoryDLQStore {
+	return &memoryDLQStore{data: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (m *memoryDLQStore) Save(_ context.Context, key string, payload []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = payload
+	m.ttls[key] = ttl
+	return nil
+}
+
+func (m *memoryDLQStore) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.data[key]
+	if !ok {
+		return nil, redisClient.ErrDLQNotFound
+	}
+	return v, nil
+}
+
+func (m *memoryDLQStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memoryDLQStore) List(_ context.Context, pattern string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for k := range m.data {
+		if strings.HasPrefix(k, strings.TrimSuffix(pattern, "*")) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memoryDLQStore) Scan(ctx context.Context, pattern string, fn func([]string) error) error {
+	keys, _ := m.List(ctx, pattern)
+	if len(keys) == 0 {
+		return nil
+	}
+	return fn(keys)
+}
+
+func (m *memoryDLQStore) GetMany(_ context.Context, keys []string) ([]redisClient.DLQValue, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var values []redisClient.DLQValue
+	for _, key := range keys {
+		if v, ok := m.data[key]; ok {
+			values = append(values, redisClient.DLQValue{Key: key, Payload: v, TTL: m.ttls[key]})
+		}
+	}
+	return values, nil
+}
+
+func (m *memoryDLQStore) TTL(_ context.Context, key string) (time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.data[key]; !ok {
+		return 0, redisClient.ErrDLQNotFound
+	}
+	return m.ttls[key], nil
+}
+
+func (m *memoryDLQStore) Update(_ context.Context, key string, fn func([]byte) ([]byte, error)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.data[key]
+	if !ok {
+		return redisClient.ErrDLQNotFound
+	}
+	updated, err := fn(v)
+	if err != nil {
+		return err
+	}
+	m.data[key] = updated
+	return nil
+}
+
+func (m *memoryDLQStore) save(t *testing.T, key string, entry poller.DLQEntry) {
+	t.Helper()
+	value, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Save(context.Background(), key, value, poller.TTL72Hours)
+}
+
+// newServer serves the DLQ read, search, edit, delete, purge, export and import routes of
+// store with the real handlers. Batch replays only record the requested keys.
+func newServer(t *testing.T, store *memoryDLQStore, retried *[]string) string {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	handler := dlq.NewHandler(dlq.NewService(store, nil, nil))
+
+	router := gin.New()
+	router.GET(prefix+"/dlq", handler.GetDLQS)
+	router.GET(prefix+"/dlq/:key", handler.GetDLQ)
+	router.GET(prefix+"/dlq/:key/history", handler.GetDLQHistory)
+	router.PUT(prefix+"/dlq/:key", handler.EditDLQ)
+	router.GET(prefix+"/dlq/search", handler.SearchDLQ)
+	router.GET(prefix+"/dlq/stats", handler.AggregateDLQ)
+	router.DELETE(prefix+"/dlq", handler.PurgeDLQ)
+	router.DELETE(prefix+"/dlq/:key", handler.DeleteDLQ)
+	router.GET(prefix+"/dlq/export", handler.ExportDLQ)
+	router.POST(prefix+"/dlq/import", handler.ImportDLQ)
+	router.POST(prefix+"/dlq/retry", func(c *gin.Context) {
+		var req struct{ Keys []string }
+		c.ShouldBindJSON(&req)
+		*retried = append(*retried, req.Keys...)
+		c.JSON(http.StatusMultiStatus, gin.H{"retried": req.Keys[1:], "failed": gin.H{req.Keys[0]: "kafka down"}})
+	})
+	router.GET(prefix+"/pollers", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"items": []gin.H{{"name": "log", "state": "paused", "cycles": 7}}})
+	})
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func runCLI(t *testing.T, server string, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), append([]string{"-server", server}, args...), &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func seed(t *testing.T) *memoryDLQStore {
+	store := newMemoryDLQStore()
+	store.save(t, "dlq:menu:event-1", poller.DLQEntry{Key: "r1", Topic: poller.HoBomMessage, Payload: json.RawMessage(`{"title":"hello"}`)})
+	store.save(t, "dlq:invalid:menu:event-2", poller.DLQEntry{Key: "r2", Topic: poller.HoBomMessage, Payload: json.RawMessage(`{"title":""}`), Reason: "title: must not be empty"})
+	store.save(t, "dlq:log:event-3", poller.DLQEntry{Key: "svc", Topic: poller.HoBomLog, Value: []byte{0, 0, 0, 0, 1, 2}, Headers: []poller.DLQHeader{{Key: "content-type", Value: "application/x-protobuf"}}})
+	return store
+}
+
+// --- Tests ---
+
+func TestDLQList_FiltersByTopicAndReason(t *testing.T) {
+	server := newServer(t, seed(t), nil)
+
+	out, stderr, code := runCLI(t, server, "dlq", "list", "-topic", poller.HoBomMessage)
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if !strings.Contains(out, "dlq:menu:event-1") || !strings.Contains(out, "dlq:invalid:menu:event-2") || strings.Contains(out, "dlq:log:") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+
+	out, _, _ = runCLI(t, server, "-o", "json", "dlq", "list", "-reason", "EMPTY")
+	var entries []entry
+	if err := json.Unmarshal([]byte(out), &entries); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(entries) != 1 || entries[0].Key != "dlq:invalid:menu:event-2" {
+		t.Errorf("expected only the invalid entry, got %+v", entries)
+	}
+}
+
+func TestDLQRetry_ReportsFailedKeys(t *testing.T) {
+	var retried []string
+	server := newServer(t, seed(t), &retried)
+
+	out, _, code := runCLI(t, server, "dlq", "retry", "-prefix", "dlq:menu:", "-dry-run")
+	if code != 0 || strings.TrimSpace(out) != "dlq:menu:event-1" || len(retried) != 0 {
+		t.Fatalf("expected a dry run listing the key, got exit %d %q %v", code, out, retried)
+	}
+
+	out, stderr, code := runCLI(t, server, "dlq", "retry", "dlq:log:event-3", "dlq:menu:event-1")
+	if code != 1 || !strings.Contains(stderr, "1 of 2 keys failed") {
+		t.Errorf("expected exit 1 for the failed key, got %d %q", code, stderr)
+	}
+	if !strings.Contains(out, "dlq:log:event-3   failed: kafka down") || len(retried) != 2 {
+		t.Errorf("unexpected output %q for %v", out, retried)
+	}
+}
+
+func TestDLQSearchAndStats(t *testing.T) {
+	server := newServer(t, seed(t), nil)
+
+	out, stderr, code := runCLI(t, server, "dlq", "search", "-error", "EMPTY", "-since", "1h")
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if !strings.Contains(out, "dlq:invalid:menu:event-2") || strings.Contains(out, "dlq:menu:event-1") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+
+	out, _, code = runCLI(t, server, "dlq", "search", "-until", "2h")
+	if code != 0 || strings.Contains(out, "dlq:") {
+		t.Errorf("expected no entry failed 2h ago, got exit %d:\n%s", code, out)
+	}
+
+	out, _, _ = runCLI(t, server, "-o", "json", "dlq", "stats", "-topic", poller.HoBomMessage)
+	var agg client.Aggregation
+	if err := json.Unmarshal([]byte(out), &agg); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if agg.Total != 2 || len(agg.ByPrefix) != 2 || len(agg.ByTopic) != 1 || agg.ByTopic[0].Count != 2 {
+		t.Errorf("expected both message entries, got %+v", agg)
+	}
+
+	if _, _, code := runCLI(t, server, "dlq", "search", "-since", "yesterday"); code != 2 {
+		t.Errorf("expected a usage error for an invalid time, got exit %d", code)
+	}
+}
+
+func TestDLQEditAndHistory(t *testing.T) {
+	store := seed(t)
+	server := newServer(t, store, nil)
+	dir := t.TempDir()
+	write := func(name, payload string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(payload), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	_, stderr, code := runCLI(t, server, "dlq", "edit", "-f", write("bad.json", `{"type":"MAIL_MESSAGE","title":""}`), "dlq:invalid:menu:event-2")
+	if code != 1 || !strings.Contains(stderr, "title must not be empty") {
+		t.Errorf("expected the violations, got exit %d %q", code, stderr)
+	}
+
+	fixed := write("fixed.json", `{"type":"MAIL_MESSAGE","title":"hello","recipient":"user-2"}`)
+	out, stderr, code := runCLI(t, server, "dlq", "edit", "-f", fixed, "-version", "0", "dlq:invalid:menu:event-2")
+	if code != 0 || strings.TrimSpace(out) != "dlq:invalid:menu:event-2 edited to version 1" {
+		t.Fatalf("unexpected result, exit %d %q %q", code, out, stderr)
+	}
+	if _, stderr, code := runCLI(t, server, "dlq", "edit", "-f", fixed, "-version", "0", "dlq:invalid:menu:event-2"); code != 1 || !strings.Contains(stderr, "409") {
+		t.Errorf("expected a conflict for a stale version, got exit %d %q", code, stderr)
+	}
+
+	out, _, _ = runCLI(t, server, "-o", "json", "dlq", "history", "dlq:invalid:menu:event-2")
+	var history client.DLQHistory
+	if err := json.Unmarshal([]byte(out), &history); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(history.Items) != 2 || history.Items[0].Reason == nil || history.Items[1].Version != 1 {
+		t.Errorf("expected the failed and the edited version, got %s", out)
+	}
+
+	if _, stderr, code := runCLI(t, server, "dlq", "history", "dlq:menu:gone"); code != 1 || !strings.Contains(stderr, "not found") {
+		t.Errorf("expected not found, got exit %d %q", code, stderr)
+	}
+}
+
+func TestDLQPurge(t *testing.T) {
+	store := seed(t)
+	server := newServer(t, store, nil)
+
+	if _, _, code := runCLI(t, server, "dlq", "purge"); code != 2 {
+		t.Errorf("expected a usage error without a prefix, got exit %d", code)
+	}
+
+	out, stderr, code := runCLI(t, server, "dlq", "purge", "-prefix", "dlq:", "-reason", "must not")
+	if code != 0 || strings.TrimSpace(out) != "dlq:invalid:menu:event-2" {
+		t.Fatalf("expected only the invalid entry deleted, got exit %d %q %s", code, out, stderr)
+	}
+	if len(store.data) != 2 {
+		t.Errorf("expected 2 entries left, got %d", len(store.data))
+	}
+
+	if _, stderr, code := runCLI(t, server, "dlq", "purge", "-prefix", "dlq:log:"); code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if _, ok := store.data["dlq:log:event-3"]; ok {
+		t.Error("expected the log entry to be purged")
+	}
+}
+
+func TestDLQExportImport_RoundTrip(t *testing.T) {
+	source := seed(t)
+	file := filepath.Join(t.TempDir(), "menu.ndjson")
+
+	out, stderr, code := runCLI(t, newServer(t, source, nil), "dlq", "export", "-topic", poller.HoBomMessage, "-f", file)
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if strings.TrimSpace(out) != "exported 2 entries to "+file {
+		t.Errorf("unexpected output %q", out)
+	}
+
+	target := newMemoryDLQStore()
+	target.save(t, "dlq:menu:event-1", poller.DLQEntry{Key: "existing", Payload: json.RawMessage(`{}`)})
+	out, stderr, code = runCLI(t, newServer(t, target, nil), "-o", "json", "dlq", "import", "-f", file)
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	var result dlq.ImportResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(result.Imported) != 1 || result.Imported[0] != "dlq:invalid:menu:event-2" || len(result.Skipped) != 1 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if !bytes.Equal(target.data["dlq:invalid:menu:event-2"], source.data["dlq:invalid:menu:event-2"]) {
+		t.Errorf("expected the entry to be copied as stored, got %s", target.data["dlq:invalid:menu:event-2"])
+	}
+	if target.ttls["dlq:invalid:menu:event-2"] != poller.TTL72Hours {
+		t.Errorf("expected the TTL to be kept, got %s", target.ttls["dlq:invalid:menu:event-2"])
+	}
+}
+
+func TestPollerStatus(t *testing.T) {
+	server := newServer(t, newMemoryDLQStore(), nil)
+
+	out, stderr, code := runCLI(t, server, "poller", "status")
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if !strings.Contains(out, "NAME") || !strings.Contains(out, "log   paused  7") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+
+	if _, _, code := runCLI(t, server, "poller", "pause"); code != 2 {
+		t.Errorf("expected a usage error without a name, got exit %d", code)
+	}
+}
+
+func TestTailBacksOffWhileNothingChanges(t *testing.T) {
+	base, limit := 2*time.Second, 10*time.Second
+
+	wait := base
+	var waits []time.Duration
+	for range 4 {
+		wait = nextInterval(wait, base, limit, false)
+		waits = append(waits, wait)
+	}
+	if want := []time.Duration{4 * time.Second, 8 * time.Second, limit, limit}; !slices.Equal(waits, want) {
+		t.Errorf("expected %v, got %v", want, waits)
+	}
+	if got := nextInterval(wait, base, limit, true); got != base {
+		t.Errorf("expected the interval reset to %s after a change, got %s", base, got)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+// exportChunk is the number of keys exported per request when -topic or
+// -reason selects the keys, keeping the query string short.
+const exportChunk = 100
+
+func (c *cli) dlqExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq export", flag.ContinueOnError)
+	var f filter
+	f.register(fs)
+	file := fs.String("f", "-", "file to write, - for stdout")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	out := c.out
+	if *file != "-" {
+		fh, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		out = fh
+	}
+	lines := &lineCounter{w: out}
+
+	// prefix 만으로 선택할 수 있다면 서버가 스트리밍하는 Export 를 그대로 받는다.
+	if !f.fetchesEntries() {
+		params := &client.ExportDLQParams{}
+		if f.prefix != "" {
+			params.Prefix = &f.prefix
+		}
+		if err := c.export(ctx, params, lines); err != nil {
+			return err
+		}
+	} else {
+		keys, err := c.selectKeys(ctx, f, nil)
+		if err != nil {
+			return err
+		}
+		for chunk := range slices.Chunk(keys, exportChunk) {
+			if err := c.export(ctx, &client.ExportDLQParams{Key: &chunk}, lines); err != nil {
+				return err
+			}
+		}
+	}
+
+	if *file != "-" {
+		fmt.Fprintf(c.out, "exported %d entries to %s\n", lines.n, *file)
+	}
+	return nil
+}
+
+func (c *cli) export(ctx context.Context, params *client.ExportDLQParams, w io.Writer) error {
+	res, err := c.api.ExportDLQ(ctx, params)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return apiError(res.StatusCode, body)
+	}
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+func (c *cli) dlqImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq import", flag.ContinueOnError)
+	ttl := fs.String("ttl", "", "expiry of entries exported without a TTL, e.g. 24h (server default 72h)")
+	overwrite := fs.Bool("overwrite", false, "replace entries that already exist")
+	file := fs.String("f", "-", "file to read, - for stdin")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	var in io.Reader = os.Stdin
+	if *file != "-" {
+		fh, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		in = fh
+	}
+
+	params := &client.ImportDLQParams{Overwrite: overwrite}
+	if *ttl != "" {
+		params.Ttl = ttl
+	}
+	res, err := c.api.ImportDLQWithBodyWithResponse(ctx, params, "application/x-ndjson", in)
+	if err != nil {
+		return err
+	}
+	switch {
+	case res.JSON200 != nil:
+		result := res.JSON200
+		return c.print(result, func(w io.Writer) {
+			fmt.Fprintf(w, "imported %d entries, skipped %d existing\n", len(result.Imported), len(result.Skipped))
+		})
+	case res.JSON400 != nil || res.JSON500 != nil:
+		result := res.JSON400
+		if result == nil {
+			result = res.JSON500
+		}
+		c.print(result, func(w io.Writer) {
+			fmt.Fprintf(w, "imported %d entries, skipped %d existing before the error\n", len(result.Imported), len(result.Skipped))
+		})
+		return fmt.Errorf("%d: %s", res.StatusCode(), result.Error)
+	default:
+		return apiError(res.StatusCode(), res.Body)
+	}
+}
+
+// lineCounter counts the lines written through it.
+type lineCounter struct {
+	w io.Writer
+	n int
+}
+
+func (l *lineCounter) Write(p []byte) (int, error) {
+	n, err := l.w.Write(p)
+	l.n += bytes.Count(p[:n], []byte("\n"))
+	return n, err
+}
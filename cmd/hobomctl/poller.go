@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+func (c *cli) poller(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageError("missing poller command, see hobomctl -h")
+	}
+	if args[0] == "status" {
+		return c.pollerStatus(ctx)
+	}
+	if len(args) != 2 {
+		return usageError(fmt.Sprintf("usage: hobomctl poller %s NAME", args[0]))
+	}
+	name := client.PollerName(args[1])
+
+	var (
+		message *client.Message
+		status  int
+		body    []byte
+	)
+	switch args[0] {
+	case "pause":
+		res, err := c.api.PausePollerWithResponse(ctx, name)
+		if err != nil {
+			return err
+		}
+		message, status, body = res.JSON200, res.StatusCode(), res.Body
+	case "resume":
+		res, err := c.api.ResumePollerWithResponse(ctx, name)
+		if err != nil {
+			return err
+		}
+		message, status, body = res.JSON200, res.StatusCode(), res.Body
+	case "trigger":
+		res, err := c.api.TriggerPollerWithResponse(ctx, name)
+		if err != nil {
+			return err
+		}
+		message, status, body = res.JSON202, res.StatusCode(), res.Body
+	default:
+		return usageError(fmt.Sprintf("unknown poller command %q, see hobomctl -h", args[0]))
+	}
+	if message == nil {
+		return apiError(status, body)
+	}
+	return c.print(message, func(w io.Writer) {
+		fmt.Fprintln(w, message.Message)
+	})
+}
+
+func (c *cli) pollerStatus(ctx context.Context) error {
+	res, err := c.api.ListPollersWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	pollers := res.JSON200.Items
+	return c.print(pollers, func(w io.Writer) {
+		fmt.Fprintln(w, "NAME\tSTATE\tCYCLES\tITEMS\tPANICS\tRESTARTS\tLAST RUN\tLAST ERROR")
+		for _, p := range pollers {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
+				p.Name, p.State, p.Cycles, p.ItemsProcessed, p.Panics, p.Restarts, formatTime(p.LastRunAt), orDash(p.LastError))
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+// query is the content filter of dlq search and dlq stats, applied by the
+// server.
+type query struct {
+	prefix, topic, recipient, traceId, serviceType, error string
+	since, until                                          timeFlag
+}
+
+func (q *query) register(fs *flag.FlagSet) {
+	fs.StringVar(&q.prefix, "prefix", "", "only keys starting with the prefix, e.g. dlq:menu:")
+	fs.StringVar(&q.topic, "topic", "", "only entries of the Kafka topic")
+	fs.StringVar(&q.recipient, "recipient", "", "only messages sent to the recipient")
+	fs.StringVar(&q.traceId, "trace-id", "", "only log batches holding a log with the trace ID")
+	fs.StringVar(&q.serviceType, "service-type", "", "only log batches holding a log of the service type")
+	fs.StringVar(&q.error, "error", "", "only entries whose reason or publish error contains the text (case-insensitive)")
+	fs.Var(&q.since, "since", "only entries that failed after the time, RFC 3339 or a duration ago such as 2h")
+	fs.Var(&q.until, "until", "only entries that failed before the time, RFC 3339 or a duration ago such as 2h")
+}
+
+// timeFlag is an RFC 3339 time, or a duration before now.
+type timeFlag struct{ t *time.Time }
+
+func (f *timeFlag) String() string {
+	if f.t == nil {
+		return ""
+	}
+	return f.t.Format(time.RFC3339)
+}
+
+func (f *timeFlag) Set(s string) error {
+	if d, err := time.ParseDuration(s); err == nil {
+		t := time.Now().Add(-d)
+		f.t = &t
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("expected an RFC 3339 time or a duration: %q", s)
+	}
+	f.t = &t
+	return nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (c *cli) dlqSearch(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq search", flag.ContinueOnError)
+	var q query
+	q.register(fs)
+	limit := fs.Int("limit", 100, "maximum number of entries, at most 1000")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+	if *limit <= 0 {
+		return usageError("-limit must be positive")
+	}
+
+	res, err := c.api.SearchDLQWithResponse(ctx, &client.SearchDLQParams{
+		Prefix:      optional(q.prefix),
+		Topic:       optional(q.topic),
+		Recipient:   optional(q.recipient),
+		TraceId:     optional(q.traceId),
+		ServiceType: optional(q.serviceType),
+		Error:       optional(q.error),
+		Since:       q.since.t,
+		Until:       q.until.t,
+		Limit:       limit,
+	})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	result := res.JSON200
+	return c.print(result, func(w io.Writer) {
+		fmt.Fprintln(w, "KEY\tTOPIC\tFAILED AT\tREASON")
+		for _, hit := range result.Items {
+			reason := hit.Reason
+			if reason == nil || *reason == "" {
+				reason = hit.Error
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", hit.Key, hit.Topic, formatTime(hit.FailedAt), orDash(reason))
+		}
+		if len(result.Items) < result.Matched {
+			fmt.Fprintf(w, "(%d of %d matching entries)\n", len(result.Items), result.Matched)
+		}
+	})
+}
+
+func (c *cli) dlqStats(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dlq stats", flag.ContinueOnError)
+	var q query
+	q.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	res, err := c.api.AggregateDLQWithResponse(ctx, &client.AggregateDLQParams{
+		Prefix:      optional(q.prefix),
+		Topic:       optional(q.topic),
+		Recipient:   optional(q.recipient),
+		TraceId:     optional(q.traceId),
+		ServiceType: optional(q.serviceType),
+		Error:       optional(q.error),
+		Since:       q.since.t,
+		Until:       q.until.t,
+	})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	agg := res.JSON200
+	return c.print(agg, func(w io.Writer) {
+		fmt.Fprintf(w, "Total:\t%d\n", agg.Total)
+		for _, group := range []struct {
+			name    string
+			buckets []client.Bucket
+		}{
+			{"PREFIX", agg.ByPrefix},
+			{"TOPIC", agg.ByTopic},
+			{"REASON", agg.ByReason},
+			{"HOUR (UTC)", agg.ByHour},
+		} {
+			fmt.Fprintf(w, "\n%s\tCOUNT\tSHARE\n", group.name)
+			for _, b := range group.buckets {
+				fmt.Fprintf(w, "%s\t%d\t%.0f%%\n", b.Key, b.Count, 100*float64(b.Count)/float64(agg.Total))
+			}
+		}
+	})
+}
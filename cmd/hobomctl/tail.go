@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/pkg/client"
+)
+
+// tailEvent is a line printed by tail with -o json.
+type tailEvent struct {
+	Kind  string              `json:"kind"`
+	Time  time.Time           `json:"time"`
+	Entry *entry              `json:"entry,omitempty"`
+	Audit *client.AuditRecord `json:"audit,omitempty"`
+}
+
+// tail polls the management API and prints DLQ entries stored and, with
+// -audit, management actions recorded since it started, until ctx is done.
+// Polls that find nothing new double the interval up to -max-interval.
+func (c *cli) tail(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("tail", flag.ContinueOnError)
+	prefix := fs.String("prefix", "", "only DLQ keys starting with the prefix")
+	interval := fs.Duration("interval", 2*time.Second, "how often to poll")
+	maxInterval := fs.Duration("max-interval", 30*time.Second, "how far to back off while nothing changes")
+	withAudit := fs.Bool("audit", false, "also print management actions from the audit log")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	t := &tailer{cli: c, prefix: *prefix, audit: *withAudit}
+	// 시작 시점에 이미 있는 Entry 와 감사 기록은 출력하지 않는다.
+	if _, err := t.poll(ctx, false); err != nil {
+		return err
+	}
+	wait := *interval
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			changed, err := t.poll(ctx, true)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			wait = nextInterval(wait, *interval, *maxInterval, changed)
+			timer.Reset(wait)
+		}
+	}
+}
+
+// nextInterval returns how long to wait before the next poll: base after a
+// poll that saw a change, otherwise twice wait, at most limit.
+func nextInterval(wait, base, limit time.Duration, changed bool) time.Duration {
+	if changed {
+		return base
+	}
+	// 변화가 없으면 DLQ 목록 조회가 Redis 에 주는 부담을 줄이도록 간격을 늘린다.
+	return max(base, min(2*wait, limit))
+}
+
+type tailer struct {
+	*cli
+	prefix string
+	audit  bool
+
+	keys map[string]bool
+	// lastAuditId is the newest audit record seen.
+	lastAuditId string
+}
+
+// poll prints what changed since the last poll and reports whether the DLQ
+// keys or the audit log changed.
+func (t *tailer) poll(ctx context.Context, emit bool) (bool, error) {
+	changed, err := t.pollDLQ(ctx, emit)
+	if err != nil {
+		return false, err
+	}
+	if t.audit {
+		fresh, err := t.pollAudit(ctx, emit)
+		return changed || fresh, err
+	}
+	return changed, nil
+}
+
+func (t *tailer) pollDLQ(ctx context.Context, emit bool) (bool, error) {
+	keys, err := t.list(ctx, t.prefix)
+	if err != nil {
+		return false, err
+	}
+	changed := len(keys) != len(t.keys)
+	// 제거된 Key 는 잊어서, 같은 Key 로 다시 저장되면 다시 출력한다.
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		seen[key] = true
+		if t.keys[key] {
+			continue
+		}
+		changed = true
+		if !emit {
+			continue
+		}
+		e, err := t.entry(ctx, key)
+		if err != nil {
+			continue
+		}
+		t.emit(tailEvent{Kind: "dlq", Time: time.Now(), Entry: &e})
+	}
+	t.keys = seen
+	return changed, nil
+}
+
+func (t *tailer) pollAudit(ctx context.Context, emit bool) (bool, error) {
+	limit := 100
+	res, err := t.api.ListAuditWithResponse(ctx, &client.ListAuditParams{Limit: &limit})
+	if err != nil {
+		return false, err
+	}
+	if res.JSON200 == nil {
+		return false, apiError(res.StatusCode(), res.Body)
+	}
+	records := res.JSON200.Items
+	if len(records) == 0 {
+		return false, nil
+	}
+
+	// 최신순으로 반환되므로, 마지막으로 본 기록 이전까지를 오래된 순서로 출력한다.
+	fresh := records
+	if i := slices.IndexFunc(records, func(r client.AuditRecord) bool { return r.Id == t.lastAuditId }); i >= 0 {
+		fresh = records[:i]
+	}
+	if emit {
+		for i := len(fresh) - 1; i >= 0; i-- {
+			t.emit(tailEvent{Kind: "audit", Time: fresh[i].Time, Audit: &fresh[i]})
+		}
+	}
+	t.lastAuditId = records[0].Id
+	return len(fresh) > 0, nil
+}
+
+func (t *tailer) emit(ev tailEvent) {
+	if t.format == "json" {
+		line, _ := json.Marshal(ev)
+		fmt.Fprintf(t.out, "%s\n", line)
+		return
+	}
+	at := ev.Time.Local().Format(time.TimeOnly)
+	switch ev.Kind {
+	case "dlq":
+		fmt.Fprintf(t.out, "%s  dlq    %s  topic=%s reason=%s\n", at, ev.Entry.Key, ev.Entry.Metadata.Topic, orDash(ev.Entry.Metadata.Reason))
+	case "audit":
+		fmt.Fprintf(t.out, "%s  audit  %s %s by %s: %s (%d)\n", at, ev.Audit.Action, ev.Audit.Target, ev.Audit.Actor, ev.Audit.Outcome, ev.Audit.Status)
+	}
+}
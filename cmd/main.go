@@ -3,19 +3,29 @@ package main
 import (
 	"context"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	publisher "github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	hobomkafkapb "github.com/HoBom-s/hobom-event-processor/infra/kafka/schema/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde"
 	redisClient "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
 	"github.com/HoBom-s/hobom-event-processor/internal/dlq"
 	"github.com/HoBom-s/hobom-event-processor/internal/health"
+	"github.com/HoBom-s/hobom-event-processor/internal/management"
+	"github.com/HoBom-s/hobom-event-processor/internal/openapi"
 	"github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"github.com/HoBom-s/hobom-event-processor/internal/supervisor"
 	"github.com/gin-gonic/gin"
 	redis "github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -35,24 +45,86 @@ func main() {
 	defer cancel()
 
 	// 2. KafkaPublisher 생성
-	kafkaPublisher := publisher.NewKafkaPublisher(publisher.DefaultKafkaConfig([]string{"kafka:9092"}))
+	// Log 배치는 JSON 배열 크기가 크므로 zstd 로 압축하여 발행한다.
+	// 같은 Partition Key 의 Log 배치가 같은 Partition 에 쌓이도록, Log Topic 만 Hash Balancer 를 사용한다.
+	kafkaConfig := publisher.DefaultKafkaConfig([]string{"kafka:9092"})
+	zstd := kafka.Zstd
+	kafkaConfig.Topics = map[string]publisher.TopicConfig{
+		poller.HoBomLog: {Compression: &zstd, Balancer: &kafka.Hash{}},
+	}
+	// KAFKA_TRANSACTIONAL=true 일 경우, Log 배치와 DLQ 일괄 재발행을 트랜잭션으로 커밋하는 Publisher 를 사용한다.
+	// Transactional ID 는 Replica 별로 고유해야 하므로 InstanceId 를 사용한다.
+	pollerConfig := poller.DefaultConfig()
+	var kafkaPublisher publisher.KafkaPublisher
+	if os.Getenv("KAFKA_TRANSACTIONAL") == "true" {
+		kafkaPublisher, err = publisher.NewTransactionalPublisher(kafkaConfig, "hobom-event-processor-"+pollerConfig.InstanceId)
+		if err != nil {
+			slog.Error("failed to create transactional kafka publisher", "err", err)
+			os.Exit(1)
+		}
+	} else {
+		kafkaPublisher = publisher.NewKafkaPublisher(kafkaConfig)
+	}
+
+	// KAFKA_VALUE_ENCODING 이 protobuf 또는 avro 일 경우, SCHEMA_REGISTRY_URL 의 Schema Registry 에
+	// Schema 를 등록하고 Confluent Wire Format 으로 발행한다. 기본값은 JSON 이다.
+	encoding, err := serde.ParseEncoding(os.Getenv("KAFKA_VALUE_ENCODING"))
+	if err != nil {
+		slog.Error("invalid KAFKA_VALUE_ENCODING", "err", err)
+		os.Exit(1)
+	}
+	if encoding != serde.EncodingJSON {
+		registryURL := os.Getenv("SCHEMA_REGISTRY_URL")
+		if registryURL == "" {
+			slog.Error("SCHEMA_REGISTRY_URL is required for the value encoding", "encoding", encoding)
+			os.Exit(1)
+		}
+		registry := serde.NewRegistryClient(registryURL, &http.Client{Timeout: 10 * time.Second})
+		pollerConfig.Serializer, err = serde.NewSerializer(encoding, registry, hobomkafkapb.ProtoSources())
+		if err != nil {
+			slog.Error("failed to create value serializer", "err", err)
+			os.Exit(1)
+		}
+	}
 
 	// 3. RedisClient 생성
-	rc := redisClient.NewRedisDLQStore(
-		redis.NewClient(&redis.Options{
-			Addr:     "redis:6379",
-			Password: "",
-			DB:       0,
-		}),
-	)
+	redisConn := redis.NewClient(&redis.Options{
+		Addr:     "redis:6379",
+		Password: "",
+		DB:       0,
+	})
+	rc := redisClient.NewRedisDLQStore(redisConn)
+	ackJournal := redisClient.NewRedisAckJournal(redisConn)
+	// 관리 API 로 수행한 작업은 Redis Stream 에 최근 100,000 건까지 감사 기록으로 남긴다.
+	auditService := audit.NewService(redisClient.NewRedisAuditLog(redisConn, 100_000))
+
+	// 내부 관리 API 는 AUTH_* 환경변수로 설정한 Token, HMAC, JWT 인증을 요구한다.
+	// 아무것도 설정하지 않으면 모든 요청을 거부하며, 로컬 개발 시에는 AUTH_DISABLED=true 로 인증을 끌 수 있다.
+	authConfig, err := auth.ConfigFromEnv()
+	if err != nil {
+		slog.Error("invalid auth configuration", "err", err)
+		os.Exit(1)
+	}
+	guard, err := auth.NewGuard(authConfig)
+	if err != nil {
+		slog.Error("failed to create auth guard", "err", err)
+		os.Exit(1)
+	}
+	if authConfig.Disabled {
+		slog.Warn("internal API authentication is disabled")
+	}
 
 	// 4. Start polling ( Background )
-	wg := poller.StartAllPollers(ctx, conn, kafkaPublisher, rc)
+	pollerSupervisor := poller.StartAllPollers(ctx, conn, kafkaPublisher, rc, ackJournal, pollerConfig)
 
 	// 5. Start Gin server
 	router := gin.Default()
-	health.RegisterRoutes(router)
-	dlq.RegisterRoutes(router, rc, kafkaPublisher, conn)
+	router.Use(audit.RequestID())
+	health.RegisterRoutes(router, pollerSupervisor)
+	openapi.RegisterRoutes(router)
+	audit.RegisterRoutes(router, guard, auditService)
+	supervisor.RegisterRoutes(router, guard, auditService, pollerSupervisor)
+	dlq.RegisterRoutes(router, guard, auditService, rc, kafkaPublisher, conn)
 	server := &http.Server{
 		Addr:    ":8082",
 		Handler: router,
@@ -66,17 +138,53 @@ func main() {
 		}
 	}()
 
-	// 6. Listen OS Signal for Graceful Shutdown
+	// 6. Start gRPC management server
+	// HTTP 관리 API 와 같은 인증, 감사 기록을 거치며, grpc.health.v1 과 Reflection 을 함께 제공한다.
+	managementConfig := management.DefaultConfig()
+	if addr := os.Getenv("GRPC_MANAGEMENT_ADDR"); addr != "" {
+		managementConfig.Addr = addr
+	}
+	managementServer := management.NewGRPCServer(
+		managementConfig,
+		management.NewManagementServer(dlq.NewService(rc, kafkaPublisher, outboxPb.NewPatchOutboxControllerClient(conn)), pollerSupervisor),
+		management.UnaryInterceptor(guard, auditService),
+		pollerSupervisor,
+	)
+	lis, err := net.Listen("tcp", managementConfig.Addr)
+	if err != nil {
+		slog.Error("failed to listen for gRPC management server", "addr", managementConfig.Addr, "err", err)
+		os.Exit(1)
+	}
+	go func() {
+		slog.Info("gRPC management server starting", "addr", managementConfig.Addr)
+		if err := managementServer.Serve(ctx, lis); err != nil {
+			slog.Error("gRPC management server error", "err", err)
+			os.Exit(1)
+		}
+	}()
+
+	// 7. Listen OS Signal for Graceful Shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
 	slog.Info("shutdown signal received")
 
-	// 컨텍스트를 취소하여 폴러가 현재 poll 사이클을 완료 후 종료되도록 한다.
+	// DLQ 재발행이 Kafka Publisher 종료 이후에 들어오지 않도록 gRPC 서버를 먼저 NOT_SERVING 으로 전환하고 종료한다.
+	managementServer.Stop()
+
+	// 컨텍스트를 취소하여 폴러가 새로운 Row 를 가져오지 않도록 하고,
+	// 이미 선점한 Row 들은 DrainTimeout 안에 발행 및 상태 반영을 마치도록 한다.
 	cancel()
-	wg.Wait()
-	slog.Info("all pollers stopped")
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), pollerConfig.DrainTimeout)
+	report := pollerSupervisor.Drain(drainCtx)
+	drainCancel()
+	if report.Completed {
+		slog.Info("all pollers drained", "elapsed", report.Elapsed)
+	} else {
+		slog.Warn("poller drain deadline exceeded, claimed rows return to PENDING when their lease expires", "elapsed", report.Elapsed, "unfinished", report.Unfinished)
+	}
 
+	// DLQ 재발행 요청이 닫힌 Writer 로 발행하지 않도록, 처리 중인 HTTP 요청을 마치고 서버를 먼저 종료한다.
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer shutdownCancel()
 
@@ -84,5 +192,10 @@ func main() {
 		slog.Error("HTTP server shutdown failed", "err", err)
 	}
 
+	// Writer 에 남아 있는 메시지를 Flush 한다.
+	if err := kafkaPublisher.Close(); err != nil {
+		slog.Error("failed to flush kafka publisher", "err", err)
+	}
+
 	slog.Info("shutdown complete")
 }
@@ -22,9 +22,13 @@ const (
 )
 
 type Request struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventType     string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventType string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Status    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Maximum number of rows to return, oldest createdAt first. 0 returns every matching row.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque cursor taken from the previous page's nextCursor. Empty starts from the oldest row.
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,9 +77,25 @@ func (x *Request) GetStatus() string {
 	return ""
 }
 
+func (x *Request) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Request) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type Response struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*QueryResult         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*QueryResult         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Cursor for the page after this one. Empty when no more rows match.
+	NextCursor    string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,6 +137,13 @@ func (x *Response) GetItems() []*QueryResult {
 	return nil
 }
 
+func (x *Response) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type QueryResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -337,12 +364,17 @@ var File_message_outbox_v1_find_hobom_message_outbox_proto protoreflect.FileDesc
 
 const file_message_outbox_v1_find_hobom_message_outbox_proto_rawDesc = "" +
 	"\n" +
-	"1message/outbox/v1/find-hobom-message-outbox.proto\x12\x0eoutbox.message\"?\n" +
+	"1message/outbox/v1/find-hobom-message-outbox.proto\x12\x0eoutbox.message\"m\n" +
 	"\aRequest\x12\x1c\n" +
 	"\teventType\x18\x01 \x01(\tR\teventType\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"=\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"]\n" +
 	"\bResponse\x121\n" +
-	"\x05items\x18\x01 \x03(\v2\x1b.outbox.message.QueryResultR\x05items\"\xef\x02\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.outbox.message.QueryResultR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xef\x02\n" +
 	"\vQueryResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aeventId\x18\x02 \x01(\tR\aeventId\x12\x1c\n" +
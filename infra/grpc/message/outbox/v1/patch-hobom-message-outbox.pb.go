@@ -118,6 +118,387 @@ func (x *MarkFailedRequest) GetErrorMessage() string {
 	return ""
 }
 
+type MarkBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventIds      []string               `protobuf:"bytes,1,rep,name=eventIds,proto3" json:"eventIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkBatchRequest) Reset() {
+	*x = MarkBatchRequest{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkBatchRequest) ProtoMessage() {}
+
+func (x *MarkBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkBatchRequest.ProtoReflect.Descriptor instead.
+func (*MarkBatchRequest) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MarkBatchRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+type MarkFailedBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MarkFailedRequest   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkFailedBatchRequest) Reset() {
+	*x = MarkFailedBatchRequest{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkFailedBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkFailedBatchRequest) ProtoMessage() {}
+
+func (x *MarkFailedBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkFailedBatchRequest.ProtoReflect.Descriptor instead.
+func (*MarkFailedBatchRequest) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarkFailedBatchRequest) GetItems() []*MarkFailedRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MarkBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event IDs that were not updated, e.g. because the row does not exist.
+	RejectedEventIds []string `protobuf:"bytes,1,rep,name=rejectedEventIds,proto3" json:"rejectedEventIds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MarkBatchResponse) Reset() {
+	*x = MarkBatchResponse{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkBatchResponse) ProtoMessage() {}
+
+func (x *MarkBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkBatchResponse.ProtoReflect.Descriptor instead.
+func (*MarkBatchResponse) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkBatchResponse) GetRejectedEventIds() []string {
+	if x != nil {
+		return x.RejectedEventIds
+	}
+	return nil
+}
+
+type ClaimTarget struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	// Version observed when the row was fetched.
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimTarget) Reset() {
+	*x = ClaimTarget{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimTarget) ProtoMessage() {}
+
+func (x *ClaimTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimTarget.ProtoReflect.Descriptor instead.
+func (*ClaimTarget) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClaimTarget) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ClaimTarget) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ClaimRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Targets []*ClaimTarget         `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	// Identifies the processor instance holding the claim.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// How long the claim is held before the row may be claimed again.
+	LeaseSeconds  int32 `protobuf:"varint,3,opt,name=leaseSeconds,proto3" json:"leaseSeconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimRequest) Reset() {
+	*x = ClaimRequest{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimRequest) ProtoMessage() {}
+
+func (x *ClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimRequest.ProtoReflect.Descriptor instead.
+func (*ClaimRequest) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClaimRequest) GetTargets() []*ClaimTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *ClaimRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ClaimRequest) GetLeaseSeconds() int32 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+type ClaimResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event IDs claimed by this request. Rows claimed elsewhere or modified since they were fetched are omitted.
+	ClaimedEventIds []string `protobuf:"bytes,1,rep,name=claimedEventIds,proto3" json:"claimedEventIds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClaimResponse) Reset() {
+	*x = ClaimResponse{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimResponse) ProtoMessage() {}
+
+func (x *ClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimResponse.ProtoReflect.Descriptor instead.
+func (*ClaimResponse) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClaimResponse) GetClaimedEventIds() []string {
+	if x != nil {
+		return x.ClaimedEventIds
+	}
+	return nil
+}
+
+type ReleaseExpiredClaimsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseExpiredClaimsRequest) Reset() {
+	*x = ReleaseExpiredClaimsRequest{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseExpiredClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseExpiredClaimsRequest) ProtoMessage() {}
+
+func (x *ReleaseExpiredClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseExpiredClaimsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseExpiredClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReleaseExpiredClaimsRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type ReleaseExpiredClaimsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      int32                  `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseExpiredClaimsResponse) Reset() {
+	*x = ReleaseExpiredClaimsResponse{}
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseExpiredClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseExpiredClaimsResponse) ProtoMessage() {}
+
+func (x *ReleaseExpiredClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseExpiredClaimsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseExpiredClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReleaseExpiredClaimsResponse) GetReleased() int32 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
 var File_message_outbox_v1_patch_hobom_message_outbox_proto protoreflect.FileDescriptor
 
 const file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDesc = "" +
@@ -127,10 +508,33 @@ const file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDesc = "" +
 	"\aeventId\x18\x01 \x01(\tR\aeventId\"Q\n" +
 	"\x11MarkFailedRequest\x12\x18\n" +
 	"\aeventId\x18\x01 \x01(\tR\aeventId\x12\"\n" +
-	"\ferrorMessage\x18\x02 \x01(\tR\ferrorMessage2\xc9\x01\n" +
+	"\ferrorMessage\x18\x02 \x01(\tR\ferrorMessage\".\n" +
+	"\x10MarkBatchRequest\x12\x1a\n" +
+	"\beventIds\x18\x01 \x03(\tR\beventIds\"Q\n" +
+	"\x16MarkFailedBatchRequest\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.outbox.message.MarkFailedRequestR\x05items\"?\n" +
+	"\x11MarkBatchResponse\x12*\n" +
+	"\x10rejectedEventIds\x18\x01 \x03(\tR\x10rejectedEventIds\"A\n" +
+	"\vClaimTarget\x12\x18\n" +
+	"\aeventId\x18\x01 \x01(\tR\aeventId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\x7f\n" +
+	"\fClaimRequest\x125\n" +
+	"\atargets\x18\x01 \x03(\v2\x1b.outbox.message.ClaimTargetR\atargets\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\"\n" +
+	"\fleaseSeconds\x18\x03 \x01(\x05R\fleaseSeconds\"9\n" +
+	"\rClaimResponse\x12(\n" +
+	"\x0fclaimedEventIds\x18\x01 \x03(\tR\x0fclaimedEventIds\";\n" +
+	"\x1bReleaseExpiredClaimsRequest\x12\x1c\n" +
+	"\teventType\x18\x01 \x01(\tR\teventType\":\n" +
+	"\x1cReleaseExpiredClaimsResponse\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\x05R\breleased2\x83\x05\n" +
 	"\x15PatchOutboxController\x12S\n" +
 	"\x1cPatchOutboxMarkAsSentUseCase\x12\x1b.outbox.message.MarkRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
-	"\x1ePatchOutboxMarkAsFailedUseCase\x12!.outbox.message.MarkFailedRequest\x1a\x16.google.protobuf.EmptyB\\ZZgithub.com/HoBom-s/hobom-event-processor/infra/grpc/v1/hobom-message-outbox;hobommessagepbb\x06proto3"
+	"\x1ePatchOutboxMarkAsFailedUseCase\x12!.outbox.message.MarkFailedRequest\x1a\x16.google.protobuf.Empty\x12h\n" +
+	"!PatchOutboxMarkAsSentBatchUseCase\x12 .outbox.message.MarkBatchRequest\x1a!.outbox.message.MarkBatchResponse\x12p\n" +
+	"#PatchOutboxMarkAsFailedBatchUseCase\x12&.outbox.message.MarkFailedBatchRequest\x1a!.outbox.message.MarkBatchResponse\x12V\n" +
+	"\x17PatchOutboxClaimUseCase\x12\x1c.outbox.message.ClaimRequest\x1a\x1d.outbox.message.ClaimResponse\x12\x83\x01\n" +
+	"&PatchOutboxReleaseExpiredClaimsUseCase\x12+.outbox.message.ReleaseExpiredClaimsRequest\x1a,.outbox.message.ReleaseExpiredClaimsResponseB\\ZZgithub.com/HoBom-s/hobom-event-processor/infra/grpc/v1/hobom-message-outbox;hobommessagepbb\x06proto3"
 
 var (
 	file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescOnce sync.Once
@@ -144,22 +548,40 @@ func file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescGZIP() []byt
 	return file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDescData
 }
 
-var file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_message_outbox_v1_patch_hobom_message_outbox_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_message_outbox_v1_patch_hobom_message_outbox_proto_goTypes = []any{
-	(*MarkRequest)(nil),       // 0: outbox.message.MarkRequest
-	(*MarkFailedRequest)(nil), // 1: outbox.message.MarkFailedRequest
-	(*emptypb.Empty)(nil),     // 2: google.protobuf.Empty
+	(*MarkRequest)(nil),                  // 0: outbox.message.MarkRequest
+	(*MarkFailedRequest)(nil),            // 1: outbox.message.MarkFailedRequest
+	(*MarkBatchRequest)(nil),             // 2: outbox.message.MarkBatchRequest
+	(*MarkFailedBatchRequest)(nil),       // 3: outbox.message.MarkFailedBatchRequest
+	(*MarkBatchResponse)(nil),            // 4: outbox.message.MarkBatchResponse
+	(*ClaimTarget)(nil),                  // 5: outbox.message.ClaimTarget
+	(*ClaimRequest)(nil),                 // 6: outbox.message.ClaimRequest
+	(*ClaimResponse)(nil),                // 7: outbox.message.ClaimResponse
+	(*ReleaseExpiredClaimsRequest)(nil),  // 8: outbox.message.ReleaseExpiredClaimsRequest
+	(*ReleaseExpiredClaimsResponse)(nil), // 9: outbox.message.ReleaseExpiredClaimsResponse
+	(*emptypb.Empty)(nil),                // 10: google.protobuf.Empty
 }
 var file_message_outbox_v1_patch_hobom_message_outbox_proto_depIdxs = []int32{
-	0, // 0: outbox.message.PatchOutboxController.PatchOutboxMarkAsSentUseCase:input_type -> outbox.message.MarkRequest
-	1, // 1: outbox.message.PatchOutboxController.PatchOutboxMarkAsFailedUseCase:input_type -> outbox.message.MarkFailedRequest
-	2, // 2: outbox.message.PatchOutboxController.PatchOutboxMarkAsSentUseCase:output_type -> google.protobuf.Empty
-	2, // 3: outbox.message.PatchOutboxController.PatchOutboxMarkAsFailedUseCase:output_type -> google.protobuf.Empty
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,  // 0: outbox.message.MarkFailedBatchRequest.items:type_name -> outbox.message.MarkFailedRequest
+	5,  // 1: outbox.message.ClaimRequest.targets:type_name -> outbox.message.ClaimTarget
+	0,  // 2: outbox.message.PatchOutboxController.PatchOutboxMarkAsSentUseCase:input_type -> outbox.message.MarkRequest
+	1,  // 3: outbox.message.PatchOutboxController.PatchOutboxMarkAsFailedUseCase:input_type -> outbox.message.MarkFailedRequest
+	2,  // 4: outbox.message.PatchOutboxController.PatchOutboxMarkAsSentBatchUseCase:input_type -> outbox.message.MarkBatchRequest
+	3,  // 5: outbox.message.PatchOutboxController.PatchOutboxMarkAsFailedBatchUseCase:input_type -> outbox.message.MarkFailedBatchRequest
+	6,  // 6: outbox.message.PatchOutboxController.PatchOutboxClaimUseCase:input_type -> outbox.message.ClaimRequest
+	8,  // 7: outbox.message.PatchOutboxController.PatchOutboxReleaseExpiredClaimsUseCase:input_type -> outbox.message.ReleaseExpiredClaimsRequest
+	10, // 8: outbox.message.PatchOutboxController.PatchOutboxMarkAsSentUseCase:output_type -> google.protobuf.Empty
+	10, // 9: outbox.message.PatchOutboxController.PatchOutboxMarkAsFailedUseCase:output_type -> google.protobuf.Empty
+	4,  // 10: outbox.message.PatchOutboxController.PatchOutboxMarkAsSentBatchUseCase:output_type -> outbox.message.MarkBatchResponse
+	4,  // 11: outbox.message.PatchOutboxController.PatchOutboxMarkAsFailedBatchUseCase:output_type -> outbox.message.MarkBatchResponse
+	7,  // 12: outbox.message.PatchOutboxController.PatchOutboxClaimUseCase:output_type -> outbox.message.ClaimResponse
+	9,  // 13: outbox.message.PatchOutboxController.PatchOutboxReleaseExpiredClaimsUseCase:output_type -> outbox.message.ReleaseExpiredClaimsResponse
+	8,  // [8:14] is the sub-list for method output_type
+	2,  // [2:8] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_message_outbox_v1_patch_hobom_message_outbox_proto_init() }
@@ -173,7 +595,7 @@ func file_message_outbox_v1_patch_hobom_message_outbox_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDesc), len(file_message_outbox_v1_patch_hobom_message_outbox_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
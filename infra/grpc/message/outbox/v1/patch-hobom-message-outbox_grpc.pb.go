@@ -20,8 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PatchOutboxController_PatchOutboxMarkAsSentUseCase_FullMethodName   = "/outbox.message.PatchOutboxController/PatchOutboxMarkAsSentUseCase"
-	PatchOutboxController_PatchOutboxMarkAsFailedUseCase_FullMethodName = "/outbox.message.PatchOutboxController/PatchOutboxMarkAsFailedUseCase"
+	PatchOutboxController_PatchOutboxMarkAsSentUseCase_FullMethodName           = "/outbox.message.PatchOutboxController/PatchOutboxMarkAsSentUseCase"
+	PatchOutboxController_PatchOutboxMarkAsFailedUseCase_FullMethodName         = "/outbox.message.PatchOutboxController/PatchOutboxMarkAsFailedUseCase"
+	PatchOutboxController_PatchOutboxMarkAsSentBatchUseCase_FullMethodName      = "/outbox.message.PatchOutboxController/PatchOutboxMarkAsSentBatchUseCase"
+	PatchOutboxController_PatchOutboxMarkAsFailedBatchUseCase_FullMethodName    = "/outbox.message.PatchOutboxController/PatchOutboxMarkAsFailedBatchUseCase"
+	PatchOutboxController_PatchOutboxClaimUseCase_FullMethodName                = "/outbox.message.PatchOutboxController/PatchOutboxClaimUseCase"
+	PatchOutboxController_PatchOutboxReleaseExpiredClaimsUseCase_FullMethodName = "/outbox.message.PatchOutboxController/PatchOutboxReleaseExpiredClaimsUseCase"
 )
 
 // PatchOutboxControllerClient is the client API for PatchOutboxController service.
@@ -30,6 +34,15 @@ const (
 type PatchOutboxControllerClient interface {
 	PatchOutboxMarkAsSentUseCase(ctx context.Context, in *MarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PatchOutboxMarkAsFailedUseCase(ctx context.Context, in *MarkFailedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Marks every listed row as SENT in a single call.
+	PatchOutboxMarkAsSentBatchUseCase(ctx context.Context, in *MarkBatchRequest, opts ...grpc.CallOption) (*MarkBatchResponse, error)
+	// Marks every listed row as FAILED with its own error message in a single call.
+	PatchOutboxMarkAsFailedBatchUseCase(ctx context.Context, in *MarkFailedBatchRequest, opts ...grpc.CallOption) (*MarkBatchResponse, error)
+	// Moves PENDING rows (or IN_FLIGHT rows whose lease expired) to IN_FLIGHT for the given owner.
+	// A row is only claimed when its version still matches, so concurrent claimers never both win.
+	PatchOutboxClaimUseCase(ctx context.Context, in *ClaimRequest, opts ...grpc.CallOption) (*ClaimResponse, error)
+	// Returns IN_FLIGHT rows whose lease has expired to PENDING.
+	PatchOutboxReleaseExpiredClaimsUseCase(ctx context.Context, in *ReleaseExpiredClaimsRequest, opts ...grpc.CallOption) (*ReleaseExpiredClaimsResponse, error)
 }
 
 type patchOutboxControllerClient struct {
@@ -60,12 +73,61 @@ func (c *patchOutboxControllerClient) PatchOutboxMarkAsFailedUseCase(ctx context
 	return out, nil
 }
 
+func (c *patchOutboxControllerClient) PatchOutboxMarkAsSentBatchUseCase(ctx context.Context, in *MarkBatchRequest, opts ...grpc.CallOption) (*MarkBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkBatchResponse)
+	err := c.cc.Invoke(ctx, PatchOutboxController_PatchOutboxMarkAsSentBatchUseCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patchOutboxControllerClient) PatchOutboxMarkAsFailedBatchUseCase(ctx context.Context, in *MarkFailedBatchRequest, opts ...grpc.CallOption) (*MarkBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkBatchResponse)
+	err := c.cc.Invoke(ctx, PatchOutboxController_PatchOutboxMarkAsFailedBatchUseCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patchOutboxControllerClient) PatchOutboxClaimUseCase(ctx context.Context, in *ClaimRequest, opts ...grpc.CallOption) (*ClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimResponse)
+	err := c.cc.Invoke(ctx, PatchOutboxController_PatchOutboxClaimUseCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patchOutboxControllerClient) PatchOutboxReleaseExpiredClaimsUseCase(ctx context.Context, in *ReleaseExpiredClaimsRequest, opts ...grpc.CallOption) (*ReleaseExpiredClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseExpiredClaimsResponse)
+	err := c.cc.Invoke(ctx, PatchOutboxController_PatchOutboxReleaseExpiredClaimsUseCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PatchOutboxControllerServer is the server API for PatchOutboxController service.
 // All implementations must embed UnimplementedPatchOutboxControllerServer
 // for forward compatibility.
 type PatchOutboxControllerServer interface {
 	PatchOutboxMarkAsSentUseCase(context.Context, *MarkRequest) (*emptypb.Empty, error)
 	PatchOutboxMarkAsFailedUseCase(context.Context, *MarkFailedRequest) (*emptypb.Empty, error)
+	// Marks every listed row as SENT in a single call.
+	PatchOutboxMarkAsSentBatchUseCase(context.Context, *MarkBatchRequest) (*MarkBatchResponse, error)
+	// Marks every listed row as FAILED with its own error message in a single call.
+	PatchOutboxMarkAsFailedBatchUseCase(context.Context, *MarkFailedBatchRequest) (*MarkBatchResponse, error)
+	// Moves PENDING rows (or IN_FLIGHT rows whose lease expired) to IN_FLIGHT for the given owner.
+	// A row is only claimed when its version still matches, so concurrent claimers never both win.
+	PatchOutboxClaimUseCase(context.Context, *ClaimRequest) (*ClaimResponse, error)
+	// Returns IN_FLIGHT rows whose lease has expired to PENDING.
+	PatchOutboxReleaseExpiredClaimsUseCase(context.Context, *ReleaseExpiredClaimsRequest) (*ReleaseExpiredClaimsResponse, error)
 	mustEmbedUnimplementedPatchOutboxControllerServer()
 }
 
@@ -82,6 +144,18 @@ func (UnimplementedPatchOutboxControllerServer) PatchOutboxMarkAsSentUseCase(con
 func (UnimplementedPatchOutboxControllerServer) PatchOutboxMarkAsFailedUseCase(context.Context, *MarkFailedRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchOutboxMarkAsFailedUseCase not implemented")
 }
+func (UnimplementedPatchOutboxControllerServer) PatchOutboxMarkAsSentBatchUseCase(context.Context, *MarkBatchRequest) (*MarkBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOutboxMarkAsSentBatchUseCase not implemented")
+}
+func (UnimplementedPatchOutboxControllerServer) PatchOutboxMarkAsFailedBatchUseCase(context.Context, *MarkFailedBatchRequest) (*MarkBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOutboxMarkAsFailedBatchUseCase not implemented")
+}
+func (UnimplementedPatchOutboxControllerServer) PatchOutboxClaimUseCase(context.Context, *ClaimRequest) (*ClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOutboxClaimUseCase not implemented")
+}
+func (UnimplementedPatchOutboxControllerServer) PatchOutboxReleaseExpiredClaimsUseCase(context.Context, *ReleaseExpiredClaimsRequest) (*ReleaseExpiredClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOutboxReleaseExpiredClaimsUseCase not implemented")
+}
 func (UnimplementedPatchOutboxControllerServer) mustEmbedUnimplementedPatchOutboxControllerServer() {}
 func (UnimplementedPatchOutboxControllerServer) testEmbeddedByValue()                               {}
 
@@ -139,6 +213,78 @@ func _PatchOutboxController_PatchOutboxMarkAsFailedUseCase_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PatchOutboxController_PatchOutboxMarkAsSentBatchUseCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatchOutboxControllerServer).PatchOutboxMarkAsSentBatchUseCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatchOutboxController_PatchOutboxMarkAsSentBatchUseCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatchOutboxControllerServer).PatchOutboxMarkAsSentBatchUseCase(ctx, req.(*MarkBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatchOutboxController_PatchOutboxMarkAsFailedBatchUseCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkFailedBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatchOutboxControllerServer).PatchOutboxMarkAsFailedBatchUseCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatchOutboxController_PatchOutboxMarkAsFailedBatchUseCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatchOutboxControllerServer).PatchOutboxMarkAsFailedBatchUseCase(ctx, req.(*MarkFailedBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatchOutboxController_PatchOutboxClaimUseCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatchOutboxControllerServer).PatchOutboxClaimUseCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatchOutboxController_PatchOutboxClaimUseCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatchOutboxControllerServer).PatchOutboxClaimUseCase(ctx, req.(*ClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatchOutboxController_PatchOutboxReleaseExpiredClaimsUseCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseExpiredClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatchOutboxControllerServer).PatchOutboxReleaseExpiredClaimsUseCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatchOutboxController_PatchOutboxReleaseExpiredClaimsUseCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatchOutboxControllerServer).PatchOutboxReleaseExpiredClaimsUseCase(ctx, req.(*ReleaseExpiredClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PatchOutboxController_ServiceDesc is the grpc.ServiceDesc for PatchOutboxController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +300,22 @@ var PatchOutboxController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PatchOutboxMarkAsFailedUseCase",
 			Handler:    _PatchOutboxController_PatchOutboxMarkAsFailedUseCase_Handler,
 		},
+		{
+			MethodName: "PatchOutboxMarkAsSentBatchUseCase",
+			Handler:    _PatchOutboxController_PatchOutboxMarkAsSentBatchUseCase_Handler,
+		},
+		{
+			MethodName: "PatchOutboxMarkAsFailedBatchUseCase",
+			Handler:    _PatchOutboxController_PatchOutboxMarkAsFailedBatchUseCase_Handler,
+		},
+		{
+			MethodName: "PatchOutboxClaimUseCase",
+			Handler:    _PatchOutboxController_PatchOutboxClaimUseCase_Handler,
+		},
+		{
+			MethodName: "PatchOutboxReleaseExpiredClaimsUseCase",
+			Handler:    _PatchOutboxController_PatchOutboxReleaseExpiredClaimsUseCase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message/outbox/v1/patch-hobom-message-outbox.proto",
@@ -0,0 +1,174 @@
+package publisher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// EventFormat selects how an event is laid out on the wire.
+type EventFormat string
+
+const (
+	// FormatRaw publishes the payload as is.
+	FormatRaw EventFormat = "raw"
+	// FormatCloudEventsStructured wraps the payload in a CloudEvents 1.0
+	// JSON envelope (structured content mode).
+	FormatCloudEventsStructured EventFormat = "cloudevents-structured"
+	// FormatCloudEventsBinary keeps the payload as is and carries the
+	// CloudEvents 1.0 attributes in ce_ Kafka headers (binary content mode).
+	FormatCloudEventsBinary EventFormat = "cloudevents-binary"
+)
+
+const (
+	// DefaultSource is the CloudEvents source of events published by this service.
+	DefaultSource = "hobom-event-processor"
+	// CloudEventsTypePrefix is prepended to the lower-cased outbox event type
+	// to build the CloudEvents type, e.g. com.hobom.outbox.message.
+	CloudEventsTypePrefix = "com.hobom.outbox."
+
+	cloudEventsSpecVersion  = "1.0"
+	cloudEventsContentType  = "application/cloudevents+json; charset=UTF-8"
+	jsonContentType         = "application/json"
+	cloudEventsHeaderPrefix = "ce_"
+)
+
+// ParseEventFormat converts a format name ("raw", "cloudevents-structured",
+// "cloudevents-binary") into an EventFormat. An empty name means raw.
+func ParseEventFormat(name string) (EventFormat, error) {
+	switch f := EventFormat(strings.ToLower(strings.TrimSpace(name))); f {
+	case "", FormatRaw:
+		return FormatRaw, nil
+	case FormatCloudEventsStructured, FormatCloudEventsBinary:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid event format: %q", name)
+	}
+}
+
+// cloudEvent holds the CloudEvents context attributes of an event.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Id              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// encodeEvent lays event out in format. The CloudEvents attributes are
+// derived from the standard outbox headers set by OutboxHeaders:
+//   - id: the outbox event ID, or for events carrying several rows a
+//     SHA-256 of their IDs so retries of the same batch share one id
+//   - type: CloudEventsTypePrefix + the lower-cased outbox event type
+//   - time: createdAt of the (first) outbox row, falling back to the
+//     original publish time
+func encodeEvent(event Event, format EventFormat, source string) (Event, error) {
+	if format == "" || format == FormatRaw {
+		return event, nil
+	}
+
+	ce, err := newCloudEvent(event, source)
+	if err != nil {
+		return event, err
+	}
+
+	switch format {
+	case FormatCloudEventsStructured:
+		if ce.DataContentType != jsonContentType || !json.Valid(event.Value) {
+			return event, errors.New("structured CloudEvents require a JSON payload")
+		}
+		ce.Data = event.Value
+		value, err := json.Marshal(ce)
+		if err != nil {
+			return event, fmt.Errorf("failed to marshal CloudEvent: %w", err)
+		}
+		event.Value = value
+		event.Headers = withHeader(event.Headers, HeaderContentType, cloudEventsContentType)
+	case FormatCloudEventsBinary:
+		headers := withHeader(event.Headers, HeaderContentType, ce.DataContentType)
+		headers = withHeader(headers, cloudEventsHeaderPrefix+"specversion", ce.SpecVersion)
+		headers = withHeader(headers, cloudEventsHeaderPrefix+"id", ce.Id)
+		headers = withHeader(headers, cloudEventsHeaderPrefix+"source", ce.Source)
+		headers = withHeader(headers, cloudEventsHeaderPrefix+"type", ce.Type)
+		if ce.Time != "" {
+			headers = withHeader(headers, cloudEventsHeaderPrefix+"time", ce.Time)
+		}
+		event.Headers = headers
+	default:
+		return event, fmt.Errorf("unsupported event format: %q", format)
+	}
+	return event, nil
+}
+
+func newCloudEvent(event Event, source string) (cloudEvent, error) {
+	var eventIds []string
+	for _, h := range event.Headers {
+		if h.Key == HeaderEventId {
+			eventIds = append(eventIds, string(h.Value))
+		}
+	}
+	if len(eventIds) == 0 {
+		return cloudEvent{}, errors.New("CloudEvents require the " + HeaderEventId + " header")
+	}
+	eventType := HeaderValue(event.Headers, HeaderEventType)
+	if eventType == "" {
+		return cloudEvent{}, errors.New("CloudEvents require the " + HeaderEventType + " header")
+	}
+
+	id := eventIds[0]
+	if len(eventIds) > 1 {
+		sum := sha256.Sum256([]byte(strings.Join(eventIds, "\n")))
+		id = hex.EncodeToString(sum[:])
+	}
+	if source == "" {
+		source = DefaultSource
+	}
+
+	return cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		Id:              id,
+		Source:          source,
+		Type:            CloudEventsTypePrefix + strings.ToLower(eventType),
+		Time:            cloudEventTime(event.Headers),
+		DataContentType: dataContentType(event.Headers),
+	}, nil
+}
+
+// dataContentType returns the content-type the event value was encoded
+// with, defaulting to JSON.
+func dataContentType(headers []kafka.Header) string {
+	if contentType := HeaderValue(headers, HeaderContentType); contentType != "" {
+		return contentType
+	}
+	return jsonContentType
+}
+
+// cloudEventTime returns the createdAt of the first outbox row in RFC 3339,
+// or the original publish time when createdAt is missing or unparsable.
+func cloudEventTime(headers []kafka.Header) string {
+	for _, key := range []string{HeaderSourceCreatedAt, HeaderOriginalTimestamp} {
+		if t, err := time.Parse(time.RFC3339Nano, HeaderValue(headers, key)); err == nil {
+			return t.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return ""
+}
+
+// withHeader returns a copy of headers with key set to value.
+func withHeader(headers []kafka.Header, key, value string) []kafka.Header {
+	out := make([]kafka.Header, 0, len(headers)+1)
+	for _, h := range headers {
+		if h.Key != key {
+			out = append(out, h)
+		}
+	}
+	return append(out, kafka.Header{Key: key, Value: []byte(value)})
+}
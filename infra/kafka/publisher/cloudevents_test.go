@@ -0,0 +1,185 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func outboxEvent(rows ...OutboxRef) Event {
+	ts := time.Date(2025, 1, 1, 0, 0, 5, 0, time.UTC)
+	return Event{
+		Key:     "key",
+		Value:   []byte(`{"title":"hi"}`),
+		Headers: OutboxHeaders("MESSAGE", "replica-a", ts, rows...),
+		Topic:   "hobom.messages",
+	}
+}
+
+func TestParseEventFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    EventFormat
+		wantErr bool
+	}{
+		{"", FormatRaw, false},
+		{"raw", FormatRaw, false},
+		{"CloudEvents-Structured", FormatCloudEventsStructured, false},
+		{" cloudevents-binary ", FormatCloudEventsBinary, false},
+		{"avro", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEventFormat(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEventFormat(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseEventFormat(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeEvent_Structured(t *testing.T) {
+	event := outboxEvent(OutboxRef{EventId: "event-1", Version: 1, CreatedAt: "2025-01-01T09:00:00+09:00"})
+
+	got, err := encodeEvent(event, FormatCloudEventsStructured, "hobom-event-processor")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ce map[string]any
+	if err := json.Unmarshal(got.Value, &ce); err != nil {
+		t.Fatalf("expected JSON envelope: %v", err)
+	}
+	want := map[string]any{
+		"specversion":     "1.0",
+		"id":              "event-1",
+		"source":          "hobom-event-processor",
+		"type":            "com.hobom.outbox.message",
+		"time":            "2025-01-01T00:00:00Z",
+		"datacontenttype": "application/json",
+	}
+	for k, v := range want {
+		if ce[k] != v {
+			t.Errorf("%s = %v, want %v", k, ce[k], v)
+		}
+	}
+	if data, ok := ce["data"].(map[string]any); !ok || data["title"] != "hi" {
+		t.Errorf("expected payload as data, got %v", ce["data"])
+	}
+	if HeaderValue(got.Headers, "content-type") != "application/cloudevents+json; charset=UTF-8" {
+		t.Errorf("expected CloudEvents content type, got %v", got.Headers)
+	}
+	if string(event.Value) != `{"title":"hi"}` {
+		t.Error("expected the original event to be left untouched")
+	}
+}
+
+func TestEncodeEvent_Binary(t *testing.T) {
+	event := outboxEvent(OutboxRef{EventId: "event-1", Version: 1, CreatedAt: "not a time"})
+
+	got, err := encodeEvent(event, FormatCloudEventsBinary, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(got.Value) != `{"title":"hi"}` {
+		t.Errorf("expected payload untouched, got %s", got.Value)
+	}
+	want := map[string]string{
+		"ce_specversion": "1.0",
+		"ce_id":          "event-1",
+		"ce_source":      DefaultSource,
+		"ce_type":        "com.hobom.outbox.message",
+		// createdAt 을 해석할 수 없으면 최초 발행 시각을 사용한다.
+		"ce_time":      "2025-01-01T00:00:05Z",
+		"content-type": "application/json",
+	}
+	for k, v := range want {
+		if got := HeaderValue(got.Headers, k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestEncodeEvent_BatchIdIsStable(t *testing.T) {
+	rows := []OutboxRef{{EventId: "event-1"}, {EventId: "event-2"}}
+
+	first, _ := encodeEvent(outboxEvent(rows...), FormatCloudEventsBinary, "")
+	second, _ := encodeEvent(outboxEvent(rows...).WithAttempt(2), FormatCloudEventsBinary, "")
+
+	id := HeaderValue(first.Headers, "ce_id")
+	if id == "" || id == "event-1" {
+		t.Errorf("expected a batch id distinct from its first event, got %q", id)
+	}
+	if HeaderValue(second.Headers, "ce_id") != id {
+		t.Error("expected retries of the same batch to share an id")
+	}
+}
+
+func TestEncodeEvent_RequiresOutboxHeaders(t *testing.T) {
+	if _, err := encodeEvent(Event{Value: []byte("{}")}, FormatCloudEventsBinary, ""); err == nil {
+		t.Error("expected error for event without outbox headers")
+	}
+	if _, err := encodeEvent(Event{Value: []byte("{}")}, FormatRaw, ""); err != nil {
+		t.Errorf("expected raw format to accept any event, got %v", err)
+	}
+}
+
+func TestPublishBatch_AppliesTopicFormat(t *testing.T) {
+	var got []kafka.Message
+	cfg := DefaultKafkaConfig(nil)
+	cfg.Topics = map[string]TopicConfig{"hobom.messages": {Format: FormatCloudEventsBinary}}
+	p := &kafkaPublisher{
+		cfg: cfg,
+		writer: &mockKafkaWriter{WriteFunc: func(_ context.Context, msgs ...kafka.Message) error {
+			got = append(got, msgs...)
+			return nil
+		}},
+	}
+
+	errs := p.PublishBatch(context.Background(), []Event{
+		outboxEvent(OutboxRef{EventId: "event-1"}),
+		{Topic: "hobom.messages", Value: []byte("{}")},
+		{Topic: "hobom.logs", Value: []byte("[]")},
+	})
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("expected formatted and raw events to succeed, got %v", errs)
+	}
+	if errs[1] == nil {
+		t.Error("expected event without outbox headers to fail")
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 messages written, got %d", len(got))
+	}
+	if HeaderValue(got[0].Headers, "ce_id") != "event-1" {
+		t.Errorf("expected binary CloudEvents headers, got %v", got[0].Headers)
+	}
+	if HeaderValue(got[1].Headers, "ce_id") != "" {
+		t.Errorf("expected raw event without CloudEvents headers, got %v", got[1].Headers)
+	}
+}
+
+func TestEncodeEvent_KeepsBinaryContentType(t *testing.T) {
+	event := outboxEvent(OutboxRef{EventId: "event-1"}).WithContentType("application/x-protobuf")
+	event.Value = []byte{0, 0, 0, 0, 1, 0}
+
+	got, err := encodeEvent(event, FormatCloudEventsBinary, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ct := HeaderValue(got.Headers, HeaderContentType); ct != "application/x-protobuf" {
+		t.Errorf("expected content-type application/x-protobuf, got %q", ct)
+	}
+
+	if _, err := encodeEvent(event, FormatCloudEventsStructured, ""); err == nil {
+		t.Error("expected structured mode to reject a protobuf payload")
+	}
+}
@@ -1,9 +1,12 @@
 package publisher
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/compress"
 )
 
 // KafkaConfig holds configuration for the Kafka publisher.
@@ -12,17 +15,121 @@ type KafkaConfig struct {
 	Timeout  time.Duration
 	Acks     kafka.RequiredAcks
 	Balancer kafka.Balancer
+
+	// Compression is the codec applied to produced message sets.
+	// The zero value publishes uncompressed.
+	Compression kafka.Compression
+	// BatchSize is the maximum number of messages buffered before a flush.
+	// Zero uses the kafka-go default (100).
+	BatchSize int
+	// BatchBytes is the maximum size of a request in bytes before a flush.
+	// Zero uses the kafka-go default (1MiB).
+	BatchBytes int64
+	// BatchTimeout is how long an incomplete batch waits before being flushed.
+	// Zero uses the kafka-go default (1s).
+	BatchTimeout time.Duration
+	// Async makes Publish return as soon as the message is buffered.
+	// Delivery errors are then only logged, so the outbox can be marked SENT
+	// for events that never reached Kafka. Keep disabled for outbox topics.
+	Async bool
+
+	// Format is the wire format of published events. The zero value
+	// publishes the payload as is.
+	Format EventFormat
+	// Source is the CloudEvents source attribute of events published in a
+	// CloudEvents format.
+	Source string
+
+	// Topics holds per-topic overrides of the writer settings above.
+	Topics map[string]TopicConfig
+}
+
+// TopicConfig overrides KafkaConfig writer settings for a single topic.
+// Zero-valued fields inherit the value from KafkaConfig. Compression and
+// Async are pointers so that a topic can turn them off when the base
+// configuration enables them.
+type TopicConfig struct {
+	Balancer     kafka.Balancer
+	Compression  *kafka.Compression
+	BatchSize    int
+	BatchBytes   int64
+	BatchTimeout time.Duration
+	Async        *bool
+	Format       EventFormat
 }
 
 // DefaultKafkaConfig returns a KafkaConfig with production-safe defaults:
 //   - Timeout: 10s
 //   - Acks: RequireOne (leader acknowledgement)
 //   - Balancer: LeastBytes
+//   - BatchTimeout: 10ms (the kafka-go default of 1s delays every synchronous publish)
+//   - Format: raw, Source: hobom-event-processor
 func DefaultKafkaConfig(brokers []string) KafkaConfig {
 	return KafkaConfig{
-		Brokers:  brokers,
-		Timeout:  10 * time.Second,
-		Acks:     kafka.RequireOne,
-		Balancer: &kafka.LeastBytes{},
+		Brokers:      brokers,
+		Timeout:      10 * time.Second,
+		Acks:         kafka.RequireOne,
+		Balancer:     &kafka.LeastBytes{},
+		BatchTimeout: 10 * time.Millisecond,
+		Format:       FormatRaw,
+		Source:       DefaultSource,
+	}
+}
+
+// ParseCompression converts a codec name ("none", "gzip", "snappy", "lz4",
+// "zstd") into a kafka.Compression. An empty name means uncompressed.
+func ParseCompression(name string) (kafka.Compression, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return compress.None, nil
+	}
+
+	var c kafka.Compression
+	if err := c.UnmarshalText([]byte(name)); err != nil {
+		return compress.None, fmt.Errorf("invalid kafka compression: %w", err)
+	}
+	return c, nil
+}
+
+// forTopic returns the writer settings for topic, applying its override on
+// top of the base configuration.
+func (c KafkaConfig) forTopic(topic string) TopicConfig {
+	async := c.Async
+	compression := c.Compression
+	resolved := TopicConfig{
+		Balancer:     c.Balancer,
+		Compression:  &compression,
+		BatchSize:    c.BatchSize,
+		BatchBytes:   c.BatchBytes,
+		BatchTimeout: c.BatchTimeout,
+		Async:        &async,
+		Format:       c.Format,
+	}
+
+	override, ok := c.Topics[topic]
+	if !ok {
+		return resolved
+	}
+	if override.Balancer != nil {
+		resolved.Balancer = override.Balancer
+	}
+	if override.Compression != nil {
+		resolved.Compression = override.Compression
+	}
+	if override.BatchSize > 0 {
+		resolved.BatchSize = override.BatchSize
+	}
+	if override.BatchBytes > 0 {
+		resolved.BatchBytes = override.BatchBytes
+	}
+	if override.BatchTimeout > 0 {
+		resolved.BatchTimeout = override.BatchTimeout
+	}
+	if override.Async != nil {
+		resolved.Async = override.Async
+	}
+	if override.Format != "" {
+		resolved.Format = override.Format
 	}
+	return resolved
 }
@@ -0,0 +1,132 @@
+package publisher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/compress"
+)
+
+func TestParseCompression(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    kafka.Compression
+		wantErr bool
+	}{
+		{"", compress.None, false},
+		{"none", compress.None, false},
+		{"gzip", kafka.Gzip, false},
+		{"Snappy", kafka.Snappy, false},
+		{" lz4 ", kafka.Lz4, false},
+		{"zstd", kafka.Zstd, false},
+		{"brotli", compress.None, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCompression(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCompression(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseCompression(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKafkaConfigForTopic_InheritsBaseSettings(t *testing.T) {
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.Compression = kafka.Snappy
+	cfg.BatchSize = 50
+
+	got := cfg.forTopic("hobom.messages")
+
+	if got.Compression == nil || *got.Compression != kafka.Snappy {
+		t.Errorf("expected inherited snappy compression, got %v", got.Compression)
+	}
+	if got.BatchSize != 50 {
+		t.Errorf("expected inherited batch size 50, got %d", got.BatchSize)
+	}
+	if got.BatchTimeout != 10*time.Millisecond {
+		t.Errorf("expected inherited batch timeout 10ms, got %v", got.BatchTimeout)
+	}
+	if got.Async == nil || *got.Async {
+		t.Errorf("expected inherited sync mode, got %v", got.Async)
+	}
+}
+
+func TestKafkaConfigForTopic_AppliesOverride(t *testing.T) {
+	async := true
+	zstd := kafka.Zstd
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.BatchSize = 50
+	cfg.Topics = map[string]TopicConfig{
+		"hobom.logs": {
+			Compression: &zstd,
+			BatchBytes:  4 << 20,
+			Async:       &async,
+		},
+	}
+
+	got := cfg.forTopic("hobom.logs")
+
+	if got.Compression == nil || *got.Compression != kafka.Zstd {
+		t.Errorf("expected zstd override, got %v", got.Compression)
+	}
+	if got.BatchBytes != 4<<20 {
+		t.Errorf("expected batch bytes override, got %d", got.BatchBytes)
+	}
+	if got.BatchSize != 50 {
+		t.Errorf("expected batch size to fall back to base config, got %d", got.BatchSize)
+	}
+	if got.Async == nil || !*got.Async {
+		t.Errorf("expected async override, got %v", got.Async)
+	}
+}
+
+func TestKafkaConfigForTopic_OverridesCompressionBackToNone(t *testing.T) {
+	none := compress.None
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.Compression = kafka.Zstd
+	cfg.Topics = map[string]TopicConfig{
+		"hobom.messages": {Compression: &none, BatchSize: 10},
+		"hobom.logs":     {BatchSize: 10},
+	}
+
+	if got := cfg.forTopic("hobom.messages").Compression; got == nil || *got != compress.None {
+		t.Errorf("expected the none override, got %v", got)
+	}
+	if got := cfg.forTopic("hobom.logs").Compression; got == nil || *got != kafka.Zstd {
+		t.Errorf("expected an override without compression to inherit zstd, got %v", got)
+	}
+}
+
+func TestKafkaConfigForTopic_BalancerOverride(t *testing.T) {
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.Topics = map[string]TopicConfig{
+		"hobom.logs": {Balancer: &kafka.Hash{}},
+	}
+
+	if _, ok := cfg.forTopic("hobom.logs").Balancer.(*kafka.Hash); !ok {
+		t.Errorf("expected the Hash override, got %T", cfg.forTopic("hobom.logs").Balancer)
+	}
+	if _, ok := cfg.forTopic("hobom.messages").Balancer.(*kafka.LeastBytes); !ok {
+		t.Errorf("expected the LeastBytes default, got %T", cfg.forTopic("hobom.messages").Balancer)
+	}
+}
+
+func TestKafkaConfigForTopic_FormatOverride(t *testing.T) {
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.Topics = map[string]TopicConfig{
+		"hobom.messages": {Format: FormatCloudEventsStructured},
+	}
+
+	if got := cfg.forTopic("hobom.messages").Format; got != FormatCloudEventsStructured {
+		t.Errorf("expected structured override, got %q", got)
+	}
+	if got := cfg.forTopic("hobom.logs").Format; got != FormatRaw {
+		t.Errorf("expected raw default, got %q", got)
+	}
+}
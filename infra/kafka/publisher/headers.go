@@ -0,0 +1,96 @@
+package publisher
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Standard headers attached to every event so consumers can deduplicate the
+// redeliveries of this at-least-once pipeline.
+const (
+	// HeaderEventId is the outbox event ID. Events carrying several outbox
+	// rows repeat it once per row, in payload order.
+	HeaderEventId = "hobom-event-id"
+	// HeaderEventType is the outbox event type, e.g. MESSAGE or HOBOM_LOG.
+	HeaderEventType = "hobom-event-type"
+	// HeaderOutboxVersion is the outbox row version the event was built
+	// from, repeated alongside HeaderEventId.
+	HeaderOutboxVersion = "hobom-outbox-version"
+	// HeaderProducer is the processor instance that first published the event.
+	HeaderProducer = "hobom-producer"
+	// HeaderAttempt is the 1-based publish attempt, counting DLQ replays.
+	HeaderAttempt = "hobom-attempt"
+	// HeaderOriginalTimestamp is when the event was first published, in
+	// RFC 3339 with nanoseconds. It survives retries and DLQ replays.
+	HeaderOriginalTimestamp = "hobom-original-timestamp"
+	// HeaderSourceCreatedAt is the createdAt of the outbox row, repeated
+	// alongside HeaderEventId.
+	HeaderSourceCreatedAt = "hobom-source-created-at"
+	// HeaderContentType is the media type of the event value, e.g.
+	// application/json or application/x-protobuf.
+	HeaderContentType = "content-type"
+)
+
+// OutboxRef identifies an outbox row carried by an event.
+type OutboxRef struct {
+	EventId   string
+	Version   int32
+	CreatedAt string
+}
+
+// OutboxHeaders returns the standard headers for an event of eventType that
+// carries rows, first published by producer at timestamp. The attempt header
+// starts at 1; use WithAttempt to advance it.
+func OutboxHeaders(eventType, producer string, timestamp time.Time, rows ...OutboxRef) []kafka.Header {
+	headers := make([]kafka.Header, 0, len(rows)*3+4)
+	for _, row := range rows {
+		headers = append(headers,
+			kafka.Header{Key: HeaderEventId, Value: []byte(row.EventId)},
+			kafka.Header{Key: HeaderOutboxVersion, Value: []byte(strconv.FormatInt(int64(row.Version), 10))},
+			kafka.Header{Key: HeaderSourceCreatedAt, Value: []byte(row.CreatedAt)},
+		)
+	}
+	return append(headers,
+		kafka.Header{Key: HeaderEventType, Value: []byte(eventType)},
+		kafka.Header{Key: HeaderProducer, Value: []byte(producer)},
+		kafka.Header{Key: HeaderOriginalTimestamp, Value: []byte(timestamp.UTC().Format(time.RFC3339Nano))},
+		kafka.Header{Key: HeaderAttempt, Value: []byte("1")},
+	)
+}
+
+// HeaderValue returns the value of the first header named key, or "" if the
+// headers do not contain it.
+func HeaderValue(headers []kafka.Header, key string) string {
+	for _, h := range headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// Attempt returns the attempt recorded in the event's headers, or 0 if it
+// has none.
+func (e Event) Attempt() int {
+	attempt, err := strconv.Atoi(HeaderValue(e.Headers, HeaderAttempt))
+	if err != nil {
+		return 0
+	}
+	return attempt
+}
+
+// WithAttempt returns a copy of the event whose attempt header is set to
+// attempt. The original event's headers are left untouched.
+func (e Event) WithAttempt(attempt int) Event {
+	e.Headers = withHeader(e.Headers, HeaderAttempt, strconv.Itoa(attempt))
+	return e
+}
+
+// WithContentType returns a copy of the event whose content-type header is
+// set to contentType.
+func (e Event) WithContentType(contentType string) Event {
+	e.Headers = withHeader(e.Headers, HeaderContentType, contentType)
+	return e
+}
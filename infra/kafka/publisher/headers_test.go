@@ -0,0 +1,54 @@
+package publisher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func TestOutboxHeaders_RepeatsRowHeadersInOrder(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 9, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+	headers := OutboxHeaders("HOBOM_LOG", "replica-a", ts,
+		OutboxRef{EventId: "event-1", Version: 2, CreatedAt: "2025-01-01T00:00:00Z"},
+		OutboxRef{EventId: "event-2", Version: 3, CreatedAt: "2025-01-01T00:00:01Z"},
+	)
+
+	var eventIds []string
+	for _, h := range headers {
+		if h.Key == HeaderEventId {
+			eventIds = append(eventIds, string(h.Value))
+		}
+	}
+	if len(eventIds) != 2 || eventIds[0] != "event-1" || eventIds[1] != "event-2" {
+		t.Errorf("expected repeated event IDs in order, got %v", eventIds)
+	}
+	if got := HeaderValue(headers, HeaderOutboxVersion); got != "2" {
+		t.Errorf("expected first version 2, got %q", got)
+	}
+	if got := HeaderValue(headers, HeaderOriginalTimestamp); got != "2025-01-01T00:00:00Z" {
+		t.Errorf("expected UTC original timestamp, got %q", got)
+	}
+	if got := (Event{Headers: headers}).Attempt(); got != 1 {
+		t.Errorf("expected attempt 1, got %d", got)
+	}
+}
+
+func TestWithAttempt_ReplacesHeaderOnCopy(t *testing.T) {
+	original := Event{Headers: []kafka.Header{
+		{Key: HeaderEventId, Value: []byte("event-1")},
+		{Key: HeaderAttempt, Value: []byte("1")},
+	}}
+
+	retried := original.WithAttempt(3)
+
+	if retried.Attempt() != 3 {
+		t.Errorf("expected attempt 3, got %d", retried.Attempt())
+	}
+	if original.Attempt() != 1 {
+		t.Errorf("expected original event untouched, got attempt %d", original.Attempt())
+	}
+	if len(retried.Headers) != 2 || HeaderValue(retried.Headers, HeaderEventId) != "event-1" {
+		t.Errorf("expected other headers kept, got %v", retried.Headers)
+	}
+}
@@ -2,6 +2,7 @@ package publisher
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/segmentio/kafka-go"
@@ -12,57 +13,157 @@ import (
 type KafkaPublisher interface {
 	// Publish sends a single event to the topic specified in event.Topic.
 	Publish(ctx context.Context, event Event) error
+	// PublishBatch sends events in as few round trips as possible and returns
+	// one result per event, in the same order; a nil entry means success.
+	PublishBatch(ctx context.Context, events []Event) []error
 	// Close flushes pending messages and closes the underlying writer.
 	Close() error
 }
 
 type kafkaPublisher struct {
-	cfg    		KafkaConfig
-	writer 		kafkaWriter
-	hooks  		[]Hook
+	cfg          KafkaConfig
+	writer       kafkaWriter
+	topicWriters map[string]kafkaWriter
+	hooks        []Hook
 }
 
+// NewKafkaPublisher creates a KafkaPublisher. Topics listed in cfg.Topics get
+// a dedicated writer with their overrides applied; every other topic shares
+// the default writer.
 func NewKafkaPublisher(cfg KafkaConfig, hooks ...Hook) KafkaPublisher {
-	writer := &kafkaWriterImpl{
-		Writer: &kafka.Writer{
-			Addr:         kafka.TCP(cfg.Brokers...),
-			Balancer:     cfg.Balancer,
-			WriteTimeout: cfg.Timeout,
-			RequiredAcks: cfg.Acks,
-		},
+	topicWriters := make(map[string]kafkaWriter, len(cfg.Topics))
+	for topic := range cfg.Topics {
+		topicWriters[topic] = newKafkaWriter(cfg, cfg.forTopic(topic))
 	}
-	slog.Info("kafka publisher created", "brokers", cfg.Brokers)
+	slog.Info("kafka publisher created",
+		"brokers", cfg.Brokers,
+		"compression", cfg.Compression.String(),
+		"topicOverrides", len(cfg.Topics),
+	)
 
 	return &kafkaPublisher{
-		cfg:    cfg,
-		writer: writer,
-		hooks:  hooks,
+		cfg:          cfg,
+		writer:       newKafkaWriter(cfg, cfg.forTopic("")),
+		topicWriters: topicWriters,
+		hooks:        hooks,
 	}
 }
 
+func newKafkaWriter(cfg KafkaConfig, topicCfg TopicConfig) kafkaWriter {
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Balancer:     topicCfg.Balancer,
+		WriteTimeout: cfg.Timeout,
+		RequiredAcks: cfg.Acks,
+		Compression:  *topicCfg.Compression,
+		BatchSize:    topicCfg.BatchSize,
+		BatchBytes:   topicCfg.BatchBytes,
+		BatchTimeout: topicCfg.BatchTimeout,
+		Async:        *topicCfg.Async,
+	}
+	if w.Async {
+		// 비동기 모드에서는 WriteMessages가 전송 결과를 반환하지 않으므로, 실패를 로그로 남긴다.
+		w.Completion = func(messages []kafka.Message, err error) {
+			if err != nil {
+				slog.Error("async kafka write failed", "count", len(messages), "err", err)
+			}
+		}
+	}
+	return &kafkaWriterImpl{Writer: w}
+}
+
+// writerFor returns the writer configured for topic, falling back to the
+// default writer when the topic has no override.
+func (p *kafkaPublisher) writerFor(topic string) kafkaWriter {
+	if w, ok := p.topicWriters[topic]; ok {
+		return w
+	}
+	return p.writer
+}
+
 func (p *kafkaPublisher) Publish(ctx context.Context, event Event) error {
-	for _, hook := range p.hooks {
-		hook.BeforePublish(ctx, event)
+	return p.PublishBatch(ctx, []Event{event})[0]
+}
+
+func (p *kafkaPublisher) PublishBatch(ctx context.Context, events []Event) []error {
+	errs := make([]error, len(events))
+	if len(events) == 0 {
+		return errs
 	}
 
+	for _, event := range events {
+		for _, hook := range p.hooks {
+			hook.BeforePublish(ctx, event)
+		}
+	}
 
-	msg := kafka.Message{
-		Key:       []byte(event.Key),
-		Value:     event.Value,
-		Headers:   event.Headers,
-		Time:      event.Timestamp,
-		Topic:     event.Topic,
+	// Topic 별 Writer 단위로 묶어 한 번의 WriteMessages 호출로 발행하도록 한다.
+	// Topic 에 설정된 Format 으로 변환할 수 없는 Event 는 발행하지 않고 실패로 처리한다.
+	batches := make(map[kafkaWriter][]int)
+	encoded := make([]Event, len(events))
+	var order []kafkaWriter
+	for i, event := range events {
+		var err error
+		if encoded[i], err = encodeEvent(event, p.cfg.forTopic(event.Topic).Format, p.cfg.Source); err != nil {
+			errs[i] = err
+			continue
+		}
+		w := p.writerFor(event.Topic)
+		if _, ok := batches[w]; !ok {
+			order = append(order, w)
+		}
+		batches[w] = append(batches[w], i)
 	}
 
-	err := p.writer.WriteMessages(ctx, msg)
+	for _, w := range order {
+		indices := batches[w]
+		msgs := make([]kafka.Message, len(indices))
+		for j, i := range indices {
+			msgs[j] = toMessage(encoded[i])
+		}
+
+		err := w.WriteMessages(ctx, msgs...)
+		var writeErrs kafka.WriteErrors
+		if errors.As(err, &writeErrs) && len(writeErrs) == len(indices) {
+			for j, i := range indices {
+				errs[i] = writeErrs[j]
+			}
+			continue
+		}
+		for _, i := range indices {
+			errs[i] = err
+		}
+	}
 
-	for _, hook := range p.hooks {
-		hook.AfterPublish(ctx, event, err)
+	for i, event := range events {
+		for _, hook := range p.hooks {
+			hook.AfterPublish(ctx, event, errs[i])
+		}
 	}
 
-	return err
+	return errs
+}
+
+func toMessage(event Event) kafka.Message {
+	// Key가 없는 Event는 nil Key로 발행하여 Balancer가 Round-Robin으로 분산하도록 한다.
+	var key []byte
+	if event.Key != "" {
+		key = []byte(event.Key)
+	}
+
+	return kafka.Message{
+		Key:     key,
+		Value:   event.Value,
+		Headers: event.Headers,
+		Time:    event.Timestamp,
+		Topic:   event.Topic,
+	}
 }
 
 func (p *kafkaPublisher) Close() error {
-	return p.writer.Close()
+	errs := []error{p.writer.Close()}
+	for _, w := range p.topicWriters {
+		errs = append(errs, w.Close())
+	}
+	return errors.Join(errs...)
 }
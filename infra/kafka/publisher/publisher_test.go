@@ -0,0 +1,146 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func TestPublish_RoutesToTopicWriter(t *testing.T) {
+	var defaultWrites, logWrites int
+	p := &kafkaPublisher{
+		writer: &mockKafkaWriter{WriteFunc: func(_ context.Context, msgs ...kafka.Message) error {
+			defaultWrites += len(msgs)
+			return nil
+		}},
+		topicWriters: map[string]kafkaWriter{
+			"hobom.logs": &mockKafkaWriter{WriteFunc: func(_ context.Context, msgs ...kafka.Message) error {
+				logWrites += len(msgs)
+				return nil
+			}},
+		},
+	}
+
+	if err := p.Publish(context.Background(), Event{Topic: "hobom.logs", Value: []byte("[]")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.Publish(context.Background(), Event{Topic: "hobom.messages", Value: []byte("{}")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if logWrites != 1 || defaultWrites != 1 {
+		t.Errorf("expected one write per writer, got logs=%d default=%d", logWrites, defaultWrites)
+	}
+}
+
+func TestPublish_EmptyKeyIsNil(t *testing.T) {
+	var got kafka.Message
+	p := &kafkaPublisher{
+		writer: &mockKafkaWriter{WriteFunc: func(_ context.Context, msgs ...kafka.Message) error {
+			got = msgs[0]
+			return nil
+		}},
+	}
+
+	if err := p.Publish(context.Background(), Event{Topic: "hobom.logs"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Key != nil {
+		t.Errorf("expected nil key so the balancer round-robins, got %q", got.Key)
+	}
+}
+
+func TestClose_ClosesAllWriters(t *testing.T) {
+	closed := 0
+	closeErr := errors.New("close failed")
+	p := &kafkaPublisher{
+		writer: &mockKafkaWriter{CloseFunc: func() error {
+			closed++
+			return nil
+		}},
+		topicWriters: map[string]kafkaWriter{
+			"hobom.logs": &mockKafkaWriter{CloseFunc: func() error {
+				closed++
+				return closeErr
+			}},
+		},
+	}
+
+	err := p.Close()
+
+	if closed != 2 {
+		t.Errorf("expected both writers closed, got %d", closed)
+	}
+	if !errors.Is(err, closeErr) {
+		t.Errorf("expected close error to be returned, got %v", err)
+	}
+}
+
+func TestPublishBatch_MapsWriteErrorsPerEvent(t *testing.T) {
+	brokerErr := errors.New("message too large")
+	p := &kafkaPublisher{
+		writer: &mockKafkaWriter{WriteFunc: func(_ context.Context, msgs ...kafka.Message) error {
+			writeErrs := make(kafka.WriteErrors, len(msgs))
+			writeErrs[1] = brokerErr
+			return writeErrs
+		}},
+	}
+
+	errs := p.PublishBatch(context.Background(), []Event{
+		{Topic: "hobom.messages", Key: "a"},
+		{Topic: "hobom.messages", Key: "b"},
+		{Topic: "hobom.messages", Key: "c"},
+	})
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("expected events 0 and 2 to succeed, got %v", errs)
+	}
+	if !errors.Is(errs[1], brokerErr) {
+		t.Errorf("expected event 1 to carry the broker error, got %v", errs[1])
+	}
+}
+
+func TestPublishBatch_WriterErrorAppliesToItsEventsOnly(t *testing.T) {
+	writerErr := errors.New("connection refused")
+	p := &kafkaPublisher{
+		writer: &mockKafkaWriter{},
+		topicWriters: map[string]kafkaWriter{
+			"hobom.logs": &mockKafkaWriter{WriteFunc: func(context.Context, ...kafka.Message) error {
+				return writerErr
+			}},
+		},
+	}
+
+	errs := p.PublishBatch(context.Background(), []Event{
+		{Topic: "hobom.logs"},
+		{Topic: "hobom.messages"},
+		{Topic: "hobom.logs"},
+	})
+
+	if !errors.Is(errs[0], writerErr) || !errors.Is(errs[2], writerErr) {
+		t.Errorf("expected hobom.logs events to fail, got %v", errs)
+	}
+	if errs[1] != nil {
+		t.Errorf("expected hobom.messages event to succeed, got %v", errs[1])
+	}
+}
+
+type recordingHook struct {
+	before, after int
+}
+
+func (h *recordingHook) BeforePublish(context.Context, Event)       { h.before++ }
+func (h *recordingHook) AfterPublish(context.Context, Event, error) { h.after++ }
+
+func TestPublishBatch_RunsHooksPerEvent(t *testing.T) {
+	hook := &recordingHook{}
+	p := &kafkaPublisher{writer: &mockKafkaWriter{}, hooks: []Hook{hook}}
+
+	p.PublishBatch(context.Background(), []Event{{Topic: "t"}, {Topic: "t"}, {Topic: "t"}})
+
+	if hook.before != 3 || hook.after != 3 {
+		t.Errorf("expected hooks to run once per event, got before=%d after=%d", hook.before, hook.after)
+	}
+}
@@ -0,0 +1,283 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// AtomicPublisher is a KafkaPublisher that can commit a set of events to
+// Kafka in a single transaction.
+type AtomicPublisher interface {
+	KafkaPublisher
+	// PublishAtomically publishes events in one transaction: consumers
+	// reading with isolation.level=read_committed see either all of them or
+	// none. A nil error means the transaction was committed.
+	PublishAtomically(ctx context.Context, events []Event) error
+}
+
+type transactionalPublisher struct {
+	cfg   KafkaConfig
+	hooks []Hook
+
+	// franz-go 는 Client 단위로만 압축 코덱을 지정할 수 있으므로, 코덱마다 Client 를 둔다.
+	clients map[kafka.Compression]*kgo.Client
+
+	// 하나의 Producer 는 한 번에 하나의 트랜잭션만 열 수 있으므로, 호출을 직렬화한다.
+	mu sync.Mutex
+}
+
+// NewTransactionalPublisher creates an AtomicPublisher backed by idempotent,
+// transactional franz-go producers. transactionalId must be stable per
+// replica and unique across replicas: Kafka fences a producer when another
+// one starts with the same ID.
+//
+// Only Brokers, Timeout, Balancer, Source and the Compression and Format of
+// cfg and its per-topic overrides are used. Writes always wait for all
+// in-sync replicas. Records are partitioned like the Balancer of their topic,
+// which must be a kafka.Hash, a kafka.Murmur2Balancer, a kafka.LeastBytes or
+// a kafka.RoundRobin, so both publishers place a record alike.
+//
+// franz-go compresses per client, so one producer is created for each codec
+// in use. Producers other than the one of the top-level Compression append
+// the codec to transactionalId. A transaction holding topics with different
+// codecs is produced with the top-level Compression.
+func NewTransactionalPublisher(cfg KafkaConfig, transactionalId string, hooks ...Hook) (AtomicPublisher, error) {
+	partitioner, err := newTopicPartitioner(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	codecs := []kafka.Compression{cfg.Compression}
+	for topic := range cfg.Topics {
+		if codec := *cfg.forTopic(topic).Compression; !slices.Contains(codecs, codec) {
+			codecs = append(codecs, codec)
+		}
+	}
+
+	p := &transactionalPublisher{
+		cfg:     cfg,
+		hooks:   hooks,
+		clients: make(map[kafka.Compression]*kgo.Client, len(codecs)),
+	}
+	for _, codec := range codecs {
+		id := transactionalId
+		if codec != cfg.Compression {
+			id += "-" + codec.String()
+		}
+		client, err := kgo.NewClient(
+			kgo.SeedBrokers(cfg.Brokers...),
+			kgo.TransactionalID(id),
+			kgo.RequiredAcks(kgo.AllISRAcks()),
+			kgo.ProduceRequestTimeout(cfg.Timeout),
+			kgo.ProducerBatchCompression(toCompressionCodec(codec)),
+			kgo.RecordPartitioner(partitioner),
+		)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("failed to create transactional kafka client: %w", err)
+		}
+		p.clients[codec] = client
+	}
+	slog.Info("transactional kafka publisher created",
+		"brokers", cfg.Brokers,
+		"transactionalId", transactionalId,
+		"producers", len(p.clients),
+	)
+	return p, nil
+}
+
+func (p *transactionalPublisher) Publish(ctx context.Context, event Event) error {
+	return p.PublishAtomically(ctx, []Event{event})
+}
+
+// PublishBatch publishes events in one transaction, so every event reports
+// the same result.
+func (p *transactionalPublisher) PublishBatch(ctx context.Context, events []Event) []error {
+	errs := make([]error, len(events))
+	err := p.PublishAtomically(ctx, events)
+	for i := range errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+func (p *transactionalPublisher) PublishAtomically(ctx context.Context, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	for _, event := range events {
+		for _, hook := range p.hooks {
+			hook.BeforePublish(ctx, event)
+		}
+	}
+
+	err := p.transact(ctx, events)
+
+	for _, event := range events {
+		for _, hook := range p.hooks {
+			hook.AfterPublish(ctx, event, err)
+		}
+	}
+	return err
+}
+
+// transact produces events inside a transaction and commits it, aborting
+// the transaction if any record fails.
+func (p *transactionalPublisher) transact(ctx context.Context, events []Event) error {
+	records := make([]*kgo.Record, len(events))
+	for i, event := range events {
+		encoded, err := encodeEvent(event, p.cfg.forTopic(event.Topic).Format, p.cfg.Source)
+		if err != nil {
+			return err
+		}
+		records[i] = toRecord(encoded)
+	}
+
+	client := p.clientFor(events)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := client.BeginTransaction(); err != nil {
+		return fmt.Errorf("failed to begin kafka transaction: %w", err)
+	}
+
+	if produceErr := client.ProduceSync(ctx, records...).FirstErr(); produceErr != nil {
+		// 버퍼에 남은 Record 를 버린 후 트랜잭션을 중단하도록 한다.
+		abortErr := client.AbortBufferedRecords(context.WithoutCancel(ctx))
+		if abortErr == nil {
+			abortErr = client.EndTransaction(context.WithoutCancel(ctx), kgo.TryAbort)
+		}
+		if abortErr != nil {
+			return errors.Join(produceErr, fmt.Errorf("failed to abort kafka transaction: %w", abortErr))
+		}
+		return produceErr
+	}
+
+	if err := client.EndTransaction(ctx, kgo.TryCommit); err != nil {
+		return fmt.Errorf("failed to commit kafka transaction: %w", err)
+	}
+	return nil
+}
+
+// clientFor returns the producer compressing with the codec of the topics
+// of events, or the one of the top-level Compression when they differ.
+func (p *transactionalPublisher) clientFor(events []Event) *kgo.Client {
+	codec := *p.cfg.forTopic(events[0].Topic).Compression
+	for _, event := range events[1:] {
+		if *p.cfg.forTopic(event.Topic).Compression != codec {
+			return p.clients[p.cfg.Compression]
+		}
+	}
+	return p.clients[codec]
+}
+
+func (p *transactionalPublisher) Close() error {
+	for _, client := range p.clients {
+		client.Close()
+	}
+	return nil
+}
+
+func toRecord(event Event) *kgo.Record {
+	// Key가 없는 Event는 nil Key로 발행하여 Partitioner가 분산하도록 한다.
+	var key []byte
+	if event.Key != "" {
+		key = []byte(event.Key)
+	}
+
+	headers := make([]kgo.RecordHeader, len(event.Headers))
+	for i, h := range event.Headers {
+		headers[i] = kgo.RecordHeader{Key: h.Key, Value: h.Value}
+	}
+
+	return &kgo.Record{
+		Key:       key,
+		Value:     event.Value,
+		Headers:   headers,
+		Timestamp: event.Timestamp,
+		Topic:     event.Topic,
+	}
+}
+
+// topicPartitioner partitions the records of each topic with the franz-go
+// equivalent of the Balancer configured for it.
+type topicPartitioner struct {
+	topics   map[string]kgo.Partitioner
+	fallback kgo.Partitioner
+}
+
+func newTopicPartitioner(cfg KafkaConfig) (*topicPartitioner, error) {
+	fallback, err := toPartitioner(cfg.Balancer)
+	if err != nil {
+		return nil, err
+	}
+	p := &topicPartitioner{topics: make(map[string]kgo.Partitioner, len(cfg.Topics)), fallback: fallback}
+	for topic := range cfg.Topics {
+		if p.topics[topic], err = toPartitioner(cfg.forTopic(topic).Balancer); err != nil {
+			return nil, fmt.Errorf("topic %s: %w", topic, err)
+		}
+	}
+	return p, nil
+}
+
+func (p *topicPartitioner) ForTopic(topic string) kgo.TopicPartitioner {
+	if partitioner, ok := p.topics[topic]; ok {
+		return partitioner.ForTopic(topic)
+	}
+	return p.fallback.ForTopic(topic)
+}
+
+// toPartitioner maps a kafka-go balancer to the franz-go partitioner that
+// sends a key to the same partition. With Hash and Murmur2Balancer, unkeyed
+// records stick to a partition per batch instead of being spread
+// round-robin.
+func toPartitioner(b kafka.Balancer) (kgo.Partitioner, error) {
+	switch b.(type) {
+	case *kafka.Hash:
+		// kafka.Hash 는 Sarama 와 같이 FNV-1a 해시를 int32 로 변환하여 나머지를 구한다.
+		return kgo.StickyKeyPartitioner(kgo.SaramaCompatHasher(fnv1a)), nil
+	case *kafka.Murmur2Balancer, kafka.Murmur2Balancer:
+		// nil Hasher 는 Java Client 와 같은 murmur2 Partitioner 이다.
+		return kgo.StickyKeyPartitioner(nil), nil
+	case *kafka.LeastBytes:
+		// 두 Balancer 모두 Key 를 무시하고, 대기 중인 Record 가 가장 적은 Partition 을 고른다.
+		return kgo.LeastBackupPartitioner(), nil
+	case nil, *kafka.RoundRobin:
+		// kafka.Writer 는 Balancer 가 없으면 Round-Robin 으로 분산한다.
+		return kgo.RoundRobinPartitioner(), nil
+	default:
+		return nil, fmt.Errorf("unsupported kafka balancer for transactional publishing: %T", b)
+	}
+}
+
+func fnv1a(key []byte) uint32 {
+	h := fnv.New32a()
+	h.Write(key)
+	return h.Sum32()
+}
+
+// toCompressionCodec maps a kafka-go compression to its franz-go codec.
+func toCompressionCodec(c kafka.Compression) kgo.CompressionCodec {
+	switch c {
+	case kafka.Gzip:
+		return kgo.GzipCompression()
+	case kafka.Snappy:
+		return kgo.SnappyCompression()
+	case kafka.Lz4:
+		return kgo.Lz4Compression()
+	case kafka.Zstd:
+		return kgo.ZstdCompression()
+	default:
+		return kgo.NoCompression()
+	}
+}
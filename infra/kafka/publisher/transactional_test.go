@@ -0,0 +1,200 @@
+package publisher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func newFakeCluster(t *testing.T) []string {
+	t.Helper()
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(3, "hobom.logs"))
+	if err != nil {
+		t.Fatalf("failed to start fake kafka: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+	return cluster.ListenAddrs()
+}
+
+// readCommitted returns the records of topic visible to a read_committed consumer.
+func readCommitted(t *testing.T, brokers []string, topic string) []*kgo.Record {
+	t.Helper()
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.ConsumeTopics(topic),
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create consumer: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var records []*kgo.Record
+	for ctx.Err() == nil {
+		client.PollFetches(ctx).EachRecord(func(r *kgo.Record) {
+			records = append(records, r)
+		})
+	}
+	return records
+}
+
+func TestPublishAtomically_CommitsAllEvents(t *testing.T) {
+	brokers := newFakeCluster(t)
+	cfg := DefaultKafkaConfig(brokers)
+	pub, err := NewTransactionalPublisher(cfg, "replica-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer pub.Close()
+
+	err = pub.PublishAtomically(context.Background(), []Event{
+		{Key: "trace-1", Value: []byte("[1]"), Topic: "hobom.logs", Headers: []kafka.Header{{Key: HeaderEventId, Value: []byte("event-1")}}},
+		{Key: "trace-2", Value: []byte("[2]"), Topic: "hobom.logs"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := readCommitted(t, brokers, "hobom.logs")
+	if len(records) != 2 {
+		t.Fatalf("expected 2 committed records, got %d", len(records))
+	}
+	for _, r := range records {
+		if string(r.Key) == "trace-1" && (len(r.Headers) != 1 || string(r.Headers[0].Value) != "event-1") {
+			t.Errorf("expected headers to be kept, got %v", r.Headers)
+		}
+	}
+}
+
+func TestPublishAtomically_AbortedTransactionIsInvisible(t *testing.T) {
+	brokers := newFakeCluster(t)
+	pub, err := NewTransactionalPublisher(DefaultKafkaConfig(brokers), "replica-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer pub.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := pub.PublishAtomically(ctx, []Event{{Value: []byte("[1]"), Topic: "hobom.logs"}}); err == nil {
+		t.Fatal("expected error for cancelled context")
+	}
+
+	// 중단된 트랜잭션 이후에도 다음 트랜잭션은 정상적으로 커밋되어야 한다.
+	if err := pub.PublishAtomically(context.Background(), []Event{{Value: []byte("[2]"), Topic: "hobom.logs"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := readCommitted(t, brokers, "hobom.logs")
+	if len(records) != 1 || string(records[0].Value) != "[2]" {
+		t.Errorf("expected only the committed record, got %d records", len(records))
+	}
+}
+
+func TestTransactionalPublishBatch_SharesResult(t *testing.T) {
+	brokers := newFakeCluster(t)
+	pub, err := NewTransactionalPublisher(DefaultKafkaConfig(brokers), "replica-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer pub.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs := pub.PublishBatch(ctx, []Event{{Topic: "hobom.logs"}, {Topic: "hobom.logs"}})
+
+	if errs[0] == nil || errs[1] == nil {
+		t.Errorf("expected every event to report the failure, got %v", errs)
+	}
+}
+
+func TestPublishAtomically_PartitionsKeysLikeTheKafkaWriter(t *testing.T) {
+	brokers := newFakeCluster(t)
+	cfg := DefaultKafkaConfig(brokers)
+	cfg.Topics = map[string]TopicConfig{"hobom.logs": {Balancer: &kafka.Hash{}}}
+	pub, err := NewTransactionalPublisher(cfg, "replica-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer pub.Close()
+
+	var events []Event
+	for _, key := range []string{"trace-1", "trace-2", "trace-3", "user-1", "user-2", "hobom-menu"} {
+		events = append(events, Event{Key: key, Value: []byte("[]"), Topic: "hobom.logs"})
+	}
+	if err := pub.PublishAtomically(context.Background(), events); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := readCommitted(t, brokers, "hobom.logs")
+	if len(records) != len(events) {
+		t.Fatalf("expected %d committed records, got %d", len(events), len(records))
+	}
+	for _, r := range records {
+		want := (&kafka.Hash{}).Balance(kafka.Message{Key: r.Key}, 0, 1, 2)
+		if int(r.Partition) != want {
+			t.Errorf("%s: expected partition %d like kafka.Hash, got %d", r.Key, want, r.Partition)
+		}
+	}
+}
+
+func TestNewTransactionalPublisher_CompressesPerTopic(t *testing.T) {
+	brokers := newFakeCluster(t)
+	zstd := kafka.Zstd
+	cfg := DefaultKafkaConfig(brokers)
+	cfg.Topics = map[string]TopicConfig{"hobom.logs": {Compression: &zstd}}
+	pub, err := NewTransactionalPublisher(cfg, "replica-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer pub.Close()
+
+	p := pub.(*transactionalPublisher)
+	if len(p.clients) != 2 {
+		t.Fatalf("expected a producer per codec, got %d", len(p.clients))
+	}
+	if got := p.clientFor([]Event{{Topic: "hobom.logs"}}); got != p.clients[kafka.Zstd] {
+		t.Error("expected hobom.logs to be produced with zstd")
+	}
+	if got := p.clientFor([]Event{{Topic: "hobom.logs"}, {Topic: "hobom.messages"}}); got != p.clients[cfg.Compression] {
+		t.Error("expected a transaction across codecs to use the top-level compression")
+	}
+
+	if err := pub.PublishAtomically(context.Background(), []Event{{Key: "trace-1", Value: []byte("[1]"), Topic: "hobom.logs"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if records := readCommitted(t, brokers, "hobom.logs"); len(records) != 1 || string(records[0].Value) != "[1]" {
+		t.Errorf("expected the zstd record to be committed, got %d records", len(records))
+	}
+}
+
+func TestNewTopicPartitioner_FollowsTheBalancerOfEachTopic(t *testing.T) {
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.Topics = map[string]TopicConfig{"hobom.logs": {Balancer: &kafka.Hash{}}}
+	p, err := newTopicPartitioner(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	keyed := &kgo.Record{Key: []byte("trace-1")}
+	if !p.ForTopic("hobom.logs").RequiresConsistency(keyed) {
+		t.Error("expected hobom.logs to partition keys like kafka.Hash")
+	}
+	if p.ForTopic("hobom.messages").RequiresConsistency(keyed) {
+		t.Error("expected hobom.messages to ignore keys like kafka.LeastBytes")
+	}
+}
+
+func TestNewTransactionalPublisher_RejectsUnsupportedBalancer(t *testing.T) {
+	cfg := DefaultKafkaConfig([]string{"localhost:9092"})
+	cfg.Topics = map[string]TopicConfig{"hobom.logs": {Balancer: &kafka.CRC32Balancer{}}}
+	if _, err := NewTransactionalPublisher(cfg, "replica-a"); err == nil {
+		t.Error("expected an error for a balancer without a franz-go equivalent")
+	}
+}
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: v1/commands.proto
+
+package hobomkafkapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeliverHoBomMessageCommand is the value of hobom.messages events.
+type DeliverHoBomMessageCommand struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Type      string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body      string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Recipient string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	SenderId  *string                `protobuf:"bytes,5,opt,name=sender_id,json=senderId,proto3,oneof" json:"sender_id,omitempty"`
+	// sent_at is an RFC 3339 timestamp.
+	SentAt        string `protobuf:"bytes,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverHoBomMessageCommand) Reset() {
+	*x = DeliverHoBomMessageCommand{}
+	mi := &file_v1_commands_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverHoBomMessageCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverHoBomMessageCommand) ProtoMessage() {}
+
+func (x *DeliverHoBomMessageCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_commands_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverHoBomMessageCommand.ProtoReflect.Descriptor instead.
+func (*DeliverHoBomMessageCommand) Descriptor() ([]byte, []int) {
+	return file_v1_commands_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeliverHoBomMessageCommand) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeliverHoBomMessageCommand) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeliverHoBomMessageCommand) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *DeliverHoBomMessageCommand) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *DeliverHoBomMessageCommand) GetSenderId() string {
+	if x != nil && x.SenderId != nil {
+		return *x.SenderId
+	}
+	return ""
+}
+
+func (x *DeliverHoBomMessageCommand) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+// HoBomLogMessageCommand is a single API request/response log.
+type HoBomLogMessageCommand struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceType string                 `protobuf:"bytes,1,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	Level       string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	TraceId     string                 `protobuf:"bytes,3,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	HttpMethod  string                 `protobuf:"bytes,5,opt,name=http_method,json=httpMethod,proto3" json:"http_method,omitempty"`
+	Path        *string                `protobuf:"bytes,6,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	StatusCode  int32                  `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Host        string                 `protobuf:"bytes,8,opt,name=host,proto3" json:"host,omitempty"`
+	UserId      string                 `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// payload_json is the original log payload as a JSON object, empty if absent.
+	PayloadJson   string `protobuf:"bytes,10,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoBomLogMessageCommand) Reset() {
+	*x = HoBomLogMessageCommand{}
+	mi := &file_v1_commands_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoBomLogMessageCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoBomLogMessageCommand) ProtoMessage() {}
+
+func (x *HoBomLogMessageCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_commands_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoBomLogMessageCommand.ProtoReflect.Descriptor instead.
+func (*HoBomLogMessageCommand) Descriptor() ([]byte, []int) {
+	return file_v1_commands_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HoBomLogMessageCommand) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetHttpMethod() string {
+	if x != nil {
+		return x.HttpMethod
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HoBomLogMessageCommand) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HoBomLogMessageCommand) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+// HoBomLogBatch is the value of hobom.logs events.
+type HoBomLogBatch struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Logs          []*HoBomLogMessageCommand `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoBomLogBatch) Reset() {
+	*x = HoBomLogBatch{}
+	mi := &file_v1_commands_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoBomLogBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoBomLogBatch) ProtoMessage() {}
+
+func (x *HoBomLogBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_commands_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoBomLogBatch.ProtoReflect.Descriptor instead.
+func (*HoBomLogBatch) Descriptor() ([]byte, []int) {
+	return file_v1_commands_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HoBomLogBatch) GetLogs() []*HoBomLogMessageCommand {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+var File_v1_commands_proto protoreflect.FileDescriptor
+
+const file_v1_commands_proto_rawDesc = "" +
+	"\n" +
+	"\x11v1/commands.proto\x12\x0ehobom.kafka.v1\"\xc1\x01\n" +
+	"\x1aDeliverHoBomMessageCommand\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12 \n" +
+	"\tsender_id\x18\x05 \x01(\tH\x00R\bsenderId\x88\x01\x01\x12\x17\n" +
+	"\asent_at\x18\x06 \x01(\tR\x06sentAtB\f\n" +
+	"\n" +
+	"_sender_id\"\xba\x02\n" +
+	"\x16HoBomLogMessageCommand\x12!\n" +
+	"\fservice_type\x18\x01 \x01(\tR\vserviceType\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x19\n" +
+	"\btrace_id\x18\x03 \x01(\tR\atraceId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1f\n" +
+	"\vhttp_method\x18\x05 \x01(\tR\n" +
+	"httpMethod\x12\x17\n" +
+	"\x04path\x18\x06 \x01(\tH\x00R\x04path\x88\x01\x01\x12\x1f\n" +
+	"\vstatus_code\x18\a \x01(\x05R\n" +
+	"statusCode\x12\x12\n" +
+	"\x04host\x18\b \x01(\tR\x04host\x12\x17\n" +
+	"\auser_id\x18\t \x01(\tR\x06userId\x12!\n" +
+	"\fpayload_json\x18\n" +
+	" \x01(\tR\vpayloadJsonB\a\n" +
+	"\x05_path\"K\n" +
+	"\rHoBomLogBatch\x12:\n" +
+	"\x04logs\x18\x01 \x03(\v2&.hobom.kafka.v1.HoBomLogMessageCommandR\x04logsBMZKgithub.com/HoBom-s/hobom-event-processor/infra/kafka/schema/v1;hobomkafkapbb\x06proto3"
+
+var (
+	file_v1_commands_proto_rawDescOnce sync.Once
+	file_v1_commands_proto_rawDescData []byte
+)
+
+func file_v1_commands_proto_rawDescGZIP() []byte {
+	file_v1_commands_proto_rawDescOnce.Do(func() {
+		file_v1_commands_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_commands_proto_rawDesc), len(file_v1_commands_proto_rawDesc)))
+	})
+	return file_v1_commands_proto_rawDescData
+}
+
+var file_v1_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v1_commands_proto_goTypes = []any{
+	(*DeliverHoBomMessageCommand)(nil), // 0: hobom.kafka.v1.DeliverHoBomMessageCommand
+	(*HoBomLogMessageCommand)(nil),     // 1: hobom.kafka.v1.HoBomLogMessageCommand
+	(*HoBomLogBatch)(nil),              // 2: hobom.kafka.v1.HoBomLogBatch
+}
+var file_v1_commands_proto_depIdxs = []int32{
+	1, // 0: hobom.kafka.v1.HoBomLogBatch.logs:type_name -> hobom.kafka.v1.HoBomLogMessageCommand
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_v1_commands_proto_init() }
+func file_v1_commands_proto_init() {
+	if File_v1_commands_proto != nil {
+		return
+	}
+	file_v1_commands_proto_msgTypes[0].OneofWrappers = []any{}
+	file_v1_commands_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_commands_proto_rawDesc), len(file_v1_commands_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_commands_proto_goTypes,
+		DependencyIndexes: file_v1_commands_proto_depIdxs,
+		MessageInfos:      file_v1_commands_proto_msgTypes,
+	}.Build()
+	File_v1_commands_proto = out.File
+	file_v1_commands_proto_goTypes = nil
+	file_v1_commands_proto_depIdxs = nil
+}
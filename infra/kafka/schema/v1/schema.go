@@ -0,0 +1,24 @@
+package hobomkafkapb
+
+import _ "embed"
+
+// Schemas of the Kafka event values, registered with the schema registry
+// when events are published as protobuf or Avro.
+var (
+	//go:embed commands.proto
+	CommandsProto string
+
+	//go:embed deliver_message_command.avsc
+	DeliverHoBomMessageCommandAvro string
+
+	//go:embed log_batch.avsc
+	HoBomLogBatchAvro string
+)
+
+// ProtoSources maps the path of each protobuf file in this package to its
+// source, as expected by serde.NewProtobufSerializer.
+func ProtoSources() map[string]string {
+	return map[string]string{
+		File_v1_commands_proto.Path(): CommandsProto,
+	}
+}
@@ -0,0 +1,78 @@
+package serde
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hamba/avro/v2"
+)
+
+// AvroSerializer encodes AvroConvertible values in the Confluent wire format.
+type AvroSerializer struct {
+	registry Registry
+
+	// 같은 Schema 를 반복해서 파싱하지 않도록, Schema 원문 별로 파싱 결과를 캐싱한다.
+	schemas sync.Map // map[string]avro.Schema
+}
+
+// NewAvroSerializer creates an AvroSerializer.
+func NewAvroSerializer(registry Registry) *AvroSerializer {
+	return &AvroSerializer{registry: registry}
+}
+
+// Serialize encodes v, which must be an AvroConvertible.
+func (s *AvroSerializer) Serialize(ctx context.Context, topic string, v any) ([]byte, error) {
+	a, ok := v.(AvroConvertible)
+	if !ok {
+		return nil, fmt.Errorf("%T has no Avro form", v)
+	}
+
+	source := a.AvroSchema()
+	schema, err := s.parse(source)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.registry.Register(ctx, subjectFor(topic), Schema{Type: SchemaTypeAvro, Source: source})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := avro.Marshal(schema, a.ToAvro())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal avro value: %w", err)
+	}
+	return append(appendWireHeader(nil, id), payload...), nil
+}
+
+func (s *AvroSerializer) ContentType() string { return "application/avro" }
+
+// Deserialize decodes a value written by AvroSerializer into v, resolving
+// the writer schema through the registry.
+func (s *AvroSerializer) Deserialize(ctx context.Context, data []byte, v any) error {
+	id, payload, err := ParseWireFormat(data)
+	if err != nil {
+		return err
+	}
+	stored, err := s.registry.SchemaById(ctx, id)
+	if err != nil {
+		return err
+	}
+	schema, err := s.parse(stored.Source)
+	if err != nil {
+		return err
+	}
+	return avro.Unmarshal(schema, payload, v)
+}
+
+func (s *AvroSerializer) parse(source string) (avro.Schema, error) {
+	if cached, ok := s.schemas.Load(source); ok {
+		return cached.(avro.Schema), nil
+	}
+	schema, err := avro.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid avro schema: %w", err)
+	}
+	s.schemas.Store(source, schema)
+	return schema, nil
+}
@@ -0,0 +1,169 @@
+package serde
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// SchemaType is the kind of schema stored in the registry.
+type SchemaType string
+
+const (
+	SchemaTypeAvro     SchemaType = "AVRO"
+	SchemaTypeProtobuf SchemaType = "PROTOBUF"
+)
+
+// Schema is a schema as stored in the registry.
+type Schema struct {
+	Type   SchemaType
+	Source string
+}
+
+// Registry is the port for a Confluent-compatible schema registry.
+// Implementations must be safe for concurrent use.
+type Registry interface {
+	// Register registers schema under subject, or looks it up if it is
+	// already registered, and returns its global ID.
+	Register(ctx context.Context, subject string, schema Schema) (int, error)
+	// SchemaById returns the schema with the given global ID.
+	SchemaById(ctx context.Context, id int) (Schema, error)
+}
+
+const registryContentType = "application/vnd.schemaregistry.v1+json"
+
+type registryKey struct {
+	subject string
+	source  string
+}
+
+// RegistryClient is a Registry speaking the Confluent schema registry REST
+// API. Schemas are immutable once registered, so every ID and schema is
+// cached for the lifetime of the client.
+type RegistryClient struct {
+	baseURL    string
+	httpClient *http.Client
+
+	mu      sync.RWMutex
+	ids     map[registryKey]int
+	schemas map[int]Schema
+}
+
+// NewRegistryClient creates a RegistryClient for the registry at baseURL,
+// e.g. http://schema-registry:8081. A nil httpClient uses http.DefaultClient.
+func NewRegistryClient(baseURL string, httpClient *http.Client) *RegistryClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &RegistryClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+		ids:        make(map[registryKey]int),
+		schemas:    make(map[int]Schema),
+	}
+}
+
+type registrySchema struct {
+	Schema     string     `json:"schema"`
+	SchemaType SchemaType `json:"schemaType,omitempty"`
+}
+
+type registryId struct {
+	Id int `json:"id"`
+}
+
+type registryError struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func (c *RegistryClient) Register(ctx context.Context, subject string, schema Schema) (int, error) {
+	key := registryKey{subject: subject, source: schema.Source}
+	c.mu.RLock()
+	id, ok := c.ids[key]
+	c.mu.RUnlock()
+	if ok {
+		return id, nil
+	}
+
+	req := registrySchema{Schema: schema.Source}
+	// AVRO 는 Registry 의 기본 타입이므로 schemaType 을 생략한다.
+	if schema.Type != SchemaTypeAvro {
+		req.SchemaType = schema.Type
+	}
+	var res registryId
+	if err := c.do(ctx, http.MethodPost, "/subjects/"+url.PathEscape(subject)+"/versions", req, &res); err != nil {
+		return 0, fmt.Errorf("failed to register schema for %s: %w", subject, err)
+	}
+
+	c.mu.Lock()
+	c.ids[key] = res.Id
+	c.schemas[res.Id] = schema
+	c.mu.Unlock()
+	return res.Id, nil
+}
+
+func (c *RegistryClient) SchemaById(ctx context.Context, id int) (Schema, error) {
+	c.mu.RLock()
+	schema, ok := c.schemas[id]
+	c.mu.RUnlock()
+	if ok {
+		return schema, nil
+	}
+
+	var res registrySchema
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/schemas/ids/%d", id), nil, &res); err != nil {
+		return Schema{}, fmt.Errorf("failed to fetch schema %d: %w", id, err)
+	}
+	schema = Schema{Type: res.SchemaType, Source: res.Schema}
+	if schema.Type == "" {
+		schema.Type = SchemaTypeAvro
+	}
+
+	c.mu.Lock()
+	c.schemas[id] = schema
+	c.mu.Unlock()
+	return schema, nil
+}
+
+func (c *RegistryClient) do(ctx context.Context, method, path string, body, out any) error {
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", registryContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", registryContentType)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var e registryError
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil || e.Message == "" {
+			return fmt.Errorf("schema registry returned %s", res.Status)
+		}
+		return fmt.Errorf("schema registry error %d: %s", e.ErrorCode, e.Message)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
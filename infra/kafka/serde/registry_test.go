@@ -0,0 +1,74 @@
+package serde
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde/registrytest"
+)
+
+const testAvroSchema = `{"type":"record","name":"Ping","fields":[{"name":"id","type":"string"}]}`
+
+func TestRegistryClient_RegisterCachesIds(t *testing.T) {
+	fake := registrytest.NewRegistry()
+	client := NewRegistryClient(fake.Serve(t), nil)
+	ctx := context.Background()
+
+	id, err := client.Register(ctx, "pings-value", Schema{Type: SchemaTypeAvro, Source: testAvroSchema})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	again, err := client.Register(ctx, "pings-value", Schema{Type: SchemaTypeAvro, Source: testAvroSchema})
+	if err != nil {
+		t.Fatalf("Register again: %v", err)
+	}
+
+	if id != again {
+		t.Errorf("expected the same ID, got %d and %d", id, again)
+	}
+	if fake.Requests() != 1 {
+		t.Errorf("expected 1 registry request, got %d", fake.Requests())
+	}
+	if got := fake.Subjects("pings-value"); len(got) != 1 || got[0] != id {
+		t.Errorf("expected subject pings-value to hold [%d], got %v", id, got)
+	}
+}
+
+func TestRegistryClient_SchemaById(t *testing.T) {
+	fake := registrytest.NewRegistry()
+	url := fake.Serve(t)
+	ctx := context.Background()
+
+	id, err := NewRegistryClient(url, nil).Register(ctx, "pings-value", Schema{Type: SchemaTypeProtobuf, Source: "syntax = \"proto3\";"})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	// 새로운 Client 는 캐시가 비어 있으므로 Registry 에서 Schema 를 가져온다.
+	client := NewRegistryClient(url, nil)
+	schema, err := client.SchemaById(ctx, id)
+	if err != nil {
+		t.Fatalf("SchemaById: %v", err)
+	}
+	if schema.Type != SchemaTypeProtobuf || schema.Source != "syntax = \"proto3\";" {
+		t.Errorf("unexpected schema: %+v", schema)
+	}
+
+	requests := fake.Requests()
+	if _, err := client.SchemaById(ctx, id); err != nil {
+		t.Fatalf("SchemaById again: %v", err)
+	}
+	if fake.Requests() != requests {
+		t.Error("expected the second lookup to be served from the cache")
+	}
+}
+
+func TestRegistryClient_ReportsRegistryErrors(t *testing.T) {
+	client := NewRegistryClient(registrytest.NewRegistry().Serve(t), nil)
+
+	_, err := client.SchemaById(context.Background(), 42)
+	if err == nil || !strings.Contains(err.Error(), "40403") {
+		t.Errorf("expected a 40403 registry error, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+// Package registrytest provides an in-memory Confluent-compatible schema
+// registry for tests. It serves the subset of the REST API used by
+// serde.RegistryClient over an httptest server.
+package registrytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type schemaKey struct {
+	schemaType string
+	source     string
+}
+
+// Registry is an in-memory schema registry. Like the real registry, the same
+// schema gets the same global ID under every subject. The zero value is not
+// usable; create one with NewRegistry.
+type Registry struct {
+	mu       sync.Mutex
+	ids      map[schemaKey]int
+	schemas  map[int]schemaKey
+	subjects map[string][]int
+	requests int
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		ids:      make(map[schemaKey]int),
+		schemas:  make(map[int]schemaKey),
+		subjects: make(map[string][]int),
+	}
+}
+
+// Serve starts an HTTP server for the registry, closed when the test ends,
+// and returns its base URL.
+func (r *Registry) Serve(t testing.TB) string {
+	t.Helper()
+
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// Requests returns the number of requests served so far.
+func (r *Registry) Requests() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests
+}
+
+// Subjects returns the IDs registered under subject, in registration order.
+func (r *Registry) Subjects(subject string) []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int(nil), r.subjects[subject]...)
+}
+
+type schemaRequest struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.requests++
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	path := req.URL.EscapedPath()
+	switch {
+	case req.Method == http.MethodPost && strings.HasPrefix(path, "/subjects/") && strings.HasSuffix(path, "/versions"):
+		r.register(w, req, strings.TrimSuffix(strings.TrimPrefix(path, "/subjects/"), "/versions"))
+	case req.Method == http.MethodGet && strings.HasPrefix(path, "/schemas/ids/"):
+		r.schemaById(w, strings.TrimPrefix(path, "/schemas/ids/"))
+	default:
+		writeError(w, http.StatusNotFound, 404, "HTTP 404 Not Found")
+	}
+}
+
+func (r *Registry) register(w http.ResponseWriter, req *http.Request, subject string) {
+	var body schemaRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.Schema == "" {
+		writeError(w, http.StatusUnprocessableEntity, 42201, "Invalid schema")
+		return
+	}
+	if body.SchemaType == "" {
+		body.SchemaType = "AVRO"
+	}
+
+	r.mu.Lock()
+	key := schemaKey{schemaType: body.SchemaType, source: body.Schema}
+	id, ok := r.ids[key]
+	if !ok {
+		id = len(r.ids) + 1
+		r.ids[key] = id
+		r.schemas[id] = key
+	}
+	if !contains(r.subjects[subject], id) {
+		r.subjects[subject] = append(r.subjects[subject], id)
+	}
+	r.mu.Unlock()
+
+	json.NewEncoder(w).Encode(map[string]int{"id": id})
+}
+
+func (r *Registry) schemaById(w http.ResponseWriter, rawId string) {
+	id, err := strconv.Atoi(rawId)
+	if err != nil {
+		writeError(w, http.StatusNotFound, 40403, "Schema not found")
+		return
+	}
+
+	r.mu.Lock()
+	key, ok := r.schemas[id]
+	r.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, 40403, fmt.Sprintf("Schema %d not found", id))
+		return
+	}
+
+	res := schemaRequest{Schema: key.source}
+	// Registry 와 동일하게, AVRO 는 schemaType 을 생략하여 응답한다.
+	if key.schemaType != "AVRO" {
+		res.SchemaType = key.schemaType
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{"error_code": code, "message": message})
+}
+
+func contains(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package serde
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	hobomkafkapb "github.com/HoBom-s/hobom-event-processor/infra/kafka/schema/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde/registrytest"
+	"google.golang.org/protobuf/proto"
+)
+
+type ping struct {
+	Id string
+}
+
+func (p ping) AvroSchema() string { return testAvroSchema }
+func (p ping) ToAvro() any        { return map[string]any{"id": p.Id} }
+
+func TestParseEncoding(t *testing.T) {
+	for name, want := range map[string]Encoding{"": EncodingJSON, "JSON": EncodingJSON, "protobuf": EncodingProtobuf, " avro ": EncodingAvro} {
+		got, err := ParseEncoding(name)
+		if err != nil || got != want {
+			t.Errorf("ParseEncoding(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseEncoding("xml"); err == nil {
+		t.Error("expected an error for an unknown encoding")
+	}
+}
+
+func TestParseWireFormat(t *testing.T) {
+	id, payload, err := ParseWireFormat(append(appendWireHeader(nil, 258), 'x'))
+	if err != nil {
+		t.Fatalf("ParseWireFormat: %v", err)
+	}
+	if id != 258 || string(payload) != "x" {
+		t.Errorf("got id %d payload %q", id, payload)
+	}
+
+	if _, _, err := ParseWireFormat([]byte{1, 0, 0, 0, 1}); err == nil {
+		t.Error("expected an error for an unknown magic byte")
+	}
+	if _, _, err := ParseWireFormat([]byte{0, 0}); err == nil {
+		t.Error("expected an error for a truncated value")
+	}
+}
+
+func TestProtobufSerializer_RoundTrip(t *testing.T) {
+	fake := registrytest.NewRegistry()
+	registry := NewRegistryClient(fake.Serve(t), nil)
+	s := NewProtobufSerializer(registry, hobomkafkapb.ProtoSources())
+
+	path := "/api/ping"
+	batch := &hobomkafkapb.HoBomLogBatch{Logs: []*hobomkafkapb.HoBomLogMessageCommand{
+		{ServiceType: "api", TraceId: "trace-1", Path: &path, StatusCode: 200},
+	}}
+
+	data, err := s.Serialize(context.Background(), "hobom.logs", batch)
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+
+	// HoBomLogBatch 는 파일의 세 번째 Message 이므로, Message Index 는 [2] 로 기록된다.
+	if !bytes.Equal(data[5:7], []byte{2, 4}) {
+		t.Errorf("expected message indexes [2] encoded as 02 04, got % x", data[5:7])
+	}
+
+	var got hobomkafkapb.HoBomLogBatch
+	id, err := DeserializeProtobuf(data, &got)
+	if err != nil {
+		t.Fatalf("DeserializeProtobuf: %v", err)
+	}
+	if subjects := fake.Subjects("hobom.logs-value"); len(subjects) != 1 || subjects[0] != id {
+		t.Errorf("expected schema %d under hobom.logs-value, got %v", id, subjects)
+	}
+	if !proto.Equal(&got, batch) {
+		t.Errorf("round trip mismatch: got %v", &got)
+	}
+}
+
+func TestProtobufSerializer_FirstMessageUsesShortIndexes(t *testing.T) {
+	s := NewProtobufSerializer(NewRegistryClient(registrytest.NewRegistry().Serve(t), nil), hobomkafkapb.ProtoSources())
+
+	data, err := s.Serialize(context.Background(), "hobom.messages", &hobomkafkapb.DeliverHoBomMessageCommand{Title: "hi"})
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	if data[5] != 0 {
+		t.Errorf("expected the single 0 message index, got % x", data[5:])
+	}
+}
+
+func TestProtobufSerializer_RejectsUnknownValues(t *testing.T) {
+	s := NewProtobufSerializer(NewRegistryClient(registrytest.NewRegistry().Serve(t), nil), nil)
+
+	if _, err := s.Serialize(context.Background(), "t", ping{}); err == nil {
+		t.Error("expected an error for a value without a protobuf form")
+	}
+	if _, err := s.Serialize(context.Background(), "t", &hobomkafkapb.HoBomLogBatch{}); err == nil {
+		t.Error("expected an error for a message without a registered source")
+	}
+}
+
+func TestAvroSerializer_RoundTrip(t *testing.T) {
+	fake := registrytest.NewRegistry()
+	url := fake.Serve(t)
+	ctx := context.Background()
+
+	data, err := NewAvroSerializer(NewRegistryClient(url, nil)).Serialize(ctx, "pings", ping{Id: "p-1"})
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+
+	// 다른 Client 로 역직렬화하여 Writer Schema 를 Registry 에서 조회하도록 한다.
+	var got map[string]any
+	if err := NewAvroSerializer(NewRegistryClient(url, nil)).Deserialize(ctx, data, &got); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+	if got["id"] != "p-1" {
+		t.Errorf("expected id p-1, got %v", got)
+	}
+	if len(fake.Subjects("pings-value")) != 1 {
+		t.Errorf("expected the schema under pings-value, got %v", fake.Subjects("pings-value"))
+	}
+}
+
+func TestJSONSerializer(t *testing.T) {
+	data, err := JSONSerializer{}.Serialize(context.Background(), "t", map[string]string{"id": "p-1"})
+	if err != nil || string(data) != `{"id":"p-1"}` {
+		t.Errorf("got %s, %v", data, err)
+	}
+}
@@ -0,0 +1,70 @@
+package serde
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// magicByte starts every value in the Confluent wire format, followed by the
+// 4-byte big-endian schema ID.
+const magicByte = 0
+
+// appendWireHeader appends the Confluent wire format header for schemaId.
+func appendWireHeader(dst []byte, schemaId int) []byte {
+	dst = append(dst, magicByte)
+	return binary.BigEndian.AppendUint32(dst, uint32(schemaId))
+}
+
+// ParseWireFormat splits a Confluent wire format value into its schema ID
+// and the encoded payload that follows the header.
+func ParseWireFormat(data []byte) (int, []byte, error) {
+	if len(data) < 5 {
+		return 0, nil, errors.New("value too short for the schema registry wire format")
+	}
+	if data[0] != magicByte {
+		return 0, nil, fmt.Errorf("unknown magic byte %d", data[0])
+	}
+	return int(binary.BigEndian.Uint32(data[1:5])), data[5:], nil
+}
+
+// appendMessageIndexes appends the protobuf message indexes that locate a
+// message within its schema file. The common case of the first top-level
+// message is written as a single 0.
+func appendMessageIndexes(dst []byte, indexes []int) []byte {
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return append(dst, 0)
+	}
+	dst = binary.AppendVarint(dst, int64(len(indexes)))
+	for _, i := range indexes {
+		dst = binary.AppendVarint(dst, int64(i))
+	}
+	return dst
+}
+
+// readMessageIndexes reads the protobuf message indexes at the start of data
+// and returns them with the rest of data.
+func readMessageIndexes(data []byte) ([]int, []byte, error) {
+	count, n := binary.Varint(data)
+	if n <= 0 {
+		return nil, nil, errors.New("invalid protobuf message indexes")
+	}
+	data = data[n:]
+	if count < 0 || count > int64(len(data)) {
+		return nil, nil, errors.New("invalid protobuf message indexes")
+	}
+	if count == 0 {
+		return []int{0}, data, nil
+	}
+
+	indexes := make([]int, count)
+	for i := range indexes {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, nil, errors.New("invalid protobuf message indexes")
+		}
+		indexes[i] = int(v)
+		data = data[n:]
+	}
+	return indexes, data, nil
+}
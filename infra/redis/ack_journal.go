@@ -0,0 +1,19 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// AckJournal is the port for tracking outbox events that were published to
+// Kafka but whose SENT status has not been acknowledged by the outbox backend.
+type AckJournal interface {
+	// Record adds eventIds to the journal, stamped with the current time.
+	Record(ctx context.Context, eventIds []string) error
+	// Remove drops eventIds from the journal once they are acknowledged.
+	Remove(ctx context.Context, eventIds []string) error
+	// Contains reports which of eventIds are in the journal.
+	Contains(ctx context.Context, eventIds []string) (map[string]bool, error)
+	// List returns every journaled event ID with the time it was recorded.
+	List(ctx context.Context) (map[string]time.Time, error)
+}
@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// AuditRecord is one action requested through the internal management API.
+type AuditRecord struct {
+	// Id is assigned by the AuditLog on Append and orders the records.
+	Id        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	RequestId string    `json:"requestId"`
+	// Actor is the authenticated subject, empty when authentication failed.
+	Actor      string `json:"actor"`
+	AuthMethod string `json:"authMethod,omitempty"`
+	Action     string `json:"action"`
+	// Target is the DLQ key, purge prefix, replayed keys or poller name the
+	// action applied to.
+	Target     string `json:"target"`
+	Outcome    string `json:"outcome"`
+	Status     int    `json:"status"`
+	Error      string `json:"error,omitempty"`
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+}
+
+// AuditRange selects records of an AuditLog, newest first.
+type AuditRange struct {
+	// Since and Until bound the record time; zero is unbounded.
+	Since time.Time
+	Until time.Time
+	// Before only selects records older than the record with this Id.
+	Before string
+	// Count caps the number of records returned.
+	Count int
+}
+
+// AuditLog is the port for the append-only log of management API actions.
+// Records cannot be changed or removed once appended.
+type AuditLog interface {
+	// Append stores record and returns its Id.
+	Append(ctx context.Context, record AuditRecord) (string, error)
+	// Range returns the records selected by r, newest first.
+	Range(ctx context.Context, r AuditRange) ([]AuditRecord, error)
+}
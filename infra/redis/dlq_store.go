@@ -2,18 +2,46 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrDLQNotFound is returned by DLQStore.Get, DLQStore.TTL and
+// DLQStore.Update for a key that does not exist.
+var ErrDLQNotFound = errors.New("DLQ entry not found")
+
+// DLQValue is a payload read by DLQStore.GetMany.
+type DLQValue struct {
+	Key     string
+	Payload []byte
+	// TTL is the time left before the key expires, 0 if it never expires.
+	TTL time.Duration
+}
+
 // DLQStore is the port for persisting and querying Dead Letter Queue entries.
 // Key format convention: dlq:[category]:[event-id]
 type DLQStore interface {
 	// Save stores payload under key with the given TTL.
 	Save(ctx context.Context, key string, payload []byte, ttl time.Duration) error
-	// Get retrieves the raw payload for key. Returns an error if the key does not exist.
+	// Get retrieves the raw payload for key. Returns ErrDLQNotFound if the key does not exist.
 	Get(ctx context.Context, key string) ([]byte, error)
 	// Delete removes a key from the store.
 	Delete(ctx context.Context, key string) error
 	// List returns all keys matching the glob pattern.
 	List(ctx context.Context, pattern string) ([]string, error)
+	// Scan calls fn with successive pages of the keys matching the glob
+	// pattern, each key once, without blocking the store like List does. An
+	// error from fn stops the scan and is returned.
+	Scan(ctx context.Context, pattern string, fn func(keys []string) error) error
+	// GetMany returns the payloads and TTLs of keys in one round trip,
+	// leaving out keys that do not exist.
+	GetMany(ctx context.Context, keys []string) ([]DLQValue, error)
+	// TTL returns the time left before key expires, 0 if it never expires,
+	// and ErrDLQNotFound if it does not exist.
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	// Update atomically replaces the payload of key with the one returned by
+	// fn, keeping its TTL. fn may run again with the new payload when key is
+	// changed concurrently, and an error from fn aborts the update. Returns
+	// ErrDLQNotFound if key does not exist.
+	Update(ctx context.Context, key string, fn func(payload []byte) ([]byte, error)) error
 }
\ No newline at end of file
@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// AckJournalKey is the Redis hash holding unacknowledged event IDs, shared by
+// every processor replica.
+const AckJournalKey = "outbox:unacked"
+
+type RedisAckJournal struct {
+	client *redis.Client
+}
+
+// NewRedisAckJournal creates a Redis-backed AckJournal. Event IDs are kept as
+// fields of the AckJournalKey hash with the record time in Unix milliseconds.
+func NewRedisAckJournal(client *redis.Client) *RedisAckJournal {
+	return &RedisAckJournal{
+		client: client,
+	}
+}
+
+func (j *RedisAckJournal) Record(ctx context.Context, eventIds []string) error {
+	if len(eventIds) == 0 {
+		return nil
+	}
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	values := make([]any, 0, len(eventIds)*2)
+	for _, eventId := range eventIds {
+		values = append(values, eventId, now)
+	}
+	return j.client.HSet(ctx, AckJournalKey, values...).Err()
+}
+
+func (j *RedisAckJournal) Remove(ctx context.Context, eventIds []string) error {
+	if len(eventIds) == 0 {
+		return nil
+	}
+	return j.client.HDel(ctx, AckJournalKey, eventIds...).Err()
+}
+
+func (j *RedisAckJournal) Contains(ctx context.Context, eventIds []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	if len(eventIds) == 0 {
+		return found, nil
+	}
+	values, err := j.client.HMGet(ctx, AckJournalKey, eventIds...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range values {
+		if v != nil {
+			found[eventIds[i]] = true
+		}
+	}
+	return found, nil
+}
+
+func (j *RedisAckJournal) List(ctx context.Context) (map[string]time.Time, error) {
+	fields, err := j.client.HGetAll(ctx, AckJournalKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]time.Time, len(fields))
+	for eventId, value := range fields {
+		ms, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			// 기록 시각을 알 수 없는 항목은 바로 만료되도록 한다.
+			ms = 0
+		}
+		entries[eventId] = time.UnixMilli(ms)
+	}
+	return entries, nil
+}
@@ -0,0 +1,102 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// AuditLogKey is the Redis stream holding the audit records of every
+// processor replica.
+const AuditLogKey = "audit:events"
+
+type RedisAuditLog struct {
+	client *redis.Client
+	maxLen int64
+}
+
+// NewRedisAuditLog creates a Redis-backed AuditLog. Records are appended to
+// the AuditLogKey stream, whose entry IDs serve as record Ids. Once the
+// stream holds about maxLen records the oldest are trimmed; zero keeps every
+// record.
+func NewRedisAuditLog(client *redis.Client, maxLen int64) *RedisAuditLog {
+	return &RedisAuditLog{
+		client: client,
+		maxLen: maxLen,
+	}
+}
+
+func (l *RedisAuditLog) Append(ctx context.Context, record AuditRecord) (string, error) {
+	return l.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: AuditLogKey,
+		MaxLen: l.maxLen,
+		Approx: l.maxLen > 0,
+		Values: map[string]any{
+			"time":       record.Time.UTC().Format(time.RFC3339Nano),
+			"requestId":  record.RequestId,
+			"actor":      record.Actor,
+			"authMethod": record.AuthMethod,
+			"action":     record.Action,
+			"target":     record.Target,
+			"outcome":    record.Outcome,
+			"status":     record.Status,
+			"error":      record.Error,
+			"remoteAddr": record.RemoteAddr,
+		},
+	}).Result()
+}
+
+func (l *RedisAuditLog) Range(ctx context.Context, r AuditRange) ([]AuditRecord, error) {
+	// Stream Entry ID 는 `<Unix milliseconds>-<sequence>` 형식이므로, 시간 범위를 ID 범위로 변환한다.
+	end, start := "+", "-"
+	if !r.Until.IsZero() {
+		end = strconv.FormatInt(r.Until.UnixMilli(), 10)
+	}
+	if r.Before != "" {
+		end = "(" + r.Before
+	}
+	if !r.Since.IsZero() {
+		start = strconv.FormatInt(r.Since.UnixMilli(), 10)
+	}
+
+	var (
+		messages []redis.XMessage
+		err      error
+	)
+	if r.Count > 0 {
+		messages, err = l.client.XRevRangeN(ctx, AuditLogKey, end, start, int64(r.Count)).Result()
+	} else {
+		messages, err = l.client.XRevRange(ctx, AuditLogKey, end, start).Result()
+	}
+	if err != nil {
+		return nil, err
+	}
+	records := make([]AuditRecord, len(messages))
+	for i, m := range messages {
+		records[i] = auditRecordFrom(m)
+	}
+	return records, nil
+}
+
+func auditRecordFrom(m redis.XMessage) AuditRecord {
+	field := func(name string) string {
+		s, _ := m.Values[name].(string)
+		return s
+	}
+	record := AuditRecord{
+		Id:         m.ID,
+		RequestId:  field("requestId"),
+		Actor:      field("actor"),
+		AuthMethod: field("authMethod"),
+		Action:     field("action"),
+		Target:     field("target"),
+		Outcome:    field("outcome"),
+		Error:      field("error"),
+		RemoteAddr: field("remoteAddr"),
+	}
+	record.Time, _ = time.Parse(time.RFC3339Nano, field("time"))
+	record.Status, _ = strconv.Atoi(field("status"))
+	return record
+}
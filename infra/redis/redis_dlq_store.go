@@ -2,6 +2,8 @@ package redis
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -23,7 +25,11 @@ func (s *RedisDLQStore) Save(ctx context.Context, key string, payload []byte, tt
 }
 
 func (s *RedisDLQStore) Get(ctx context.Context, key string) ([]byte, error) {
-	return s.client.Get(ctx, key).Bytes()
+	payload, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrDLQNotFound
+	}
+	return payload, err
 }
 
 func (s *RedisDLQStore) Delete(ctx context.Context, key string) error {
@@ -32,4 +38,113 @@ func (s *RedisDLQStore) Delete(ctx context.Context, key string) error {
 
 func (s *RedisDLQStore) List(ctx context.Context, pattern string) ([]string, error) {
 	return s.client.Keys(ctx, pattern).Result()
-}
\ No newline at end of file
+}
+
+// scanCount is the number of keys Scan asks Redis to look at per call.
+const scanCount = 500
+
+func (s *RedisDLQStore) Scan(ctx context.Context, pattern string, fn func(keys []string) error) error {
+	// SCAN 은 같은 Key 를 여러 번 반환할 수 있으므로, 이미 넘긴 Key 는 제외한다.
+	seen := make(map[string]struct{})
+	var cursor uint64
+	for {
+		keys, next, err := s.client.Scan(ctx, cursor, pattern, scanCount).Result()
+		if err != nil {
+			return err
+		}
+		page := keys[:0]
+		for _, key := range keys {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				page = append(page, key)
+			}
+		}
+		if len(page) > 0 {
+			if err := fn(page); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func (s *RedisDLQStore) GetMany(ctx context.Context, keys []string) ([]DLQValue, error) {
+	gets := make([]*redis.StringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			gets[i] = pipe.Get(ctx, key)
+			ttls[i] = pipe.TTL(ctx, key)
+		}
+		return nil
+	})
+	// 조회하는 사이 삭제된 Key 는 redis.Nil 로 실패하므로, 건너뛴다.
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	values := make([]DLQValue, 0, len(keys))
+	for i, key := range keys {
+		payload, err := gets[i].Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, DLQValue{Key: key, Payload: payload, TTL: max(ttls[i].Val(), 0)})
+	}
+	return values, nil
+}
+
+func (s *RedisDLQStore) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// Key 가 없으면 -2, 만료 시간이 없으면 -1 이 반환된다.
+	switch ttl {
+	case -2:
+		return 0, ErrDLQNotFound
+	case -1:
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// maxUpdateAttempts bounds how often Update retries when the key keeps being
+// changed concurrently.
+const maxUpdateAttempts = 10
+
+func (s *RedisDLQStore) Update(ctx context.Context, key string, fn func(payload []byte) ([]byte, error)) error {
+	// WATCH 이후 Key 가 변경되면 EXEC 가 실패하므로, 변경된 값으로 다시 시도한다.
+	update := func(tx *redis.Tx) error {
+		payload, err := tx.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			return ErrDLQNotFound
+		}
+		if err != nil {
+			return err
+		}
+		updated, err := fn(payload)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.SetArgs(ctx, key, updated, redis.SetArgs{KeepTTL: true})
+			return nil
+		})
+		return err
+	}
+
+	for range maxUpdateAttempts {
+		err := s.client.Watch(ctx, update, key)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("failed to update %s after %d attempts: %w", key, maxUpdateAttempts, redis.TxFailedErr)
+}
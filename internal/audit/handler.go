@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	Service *AuditService
+}
+
+func NewHandler(service *AuditService) *AuditHandler {
+	return &AuditHandler{
+		Service: service,
+	}
+}
+
+// `GET` /audit
+// 관리 API 로 수행된 작업들의 감사 기록을 최신순으로 가져온다.
+// actor, action, target(prefix), outcome, requestId, since, until(RFC3339), cursor, limit 으로 필터링할 수 있다.
+// ex) ?action=dlq.retry&since=2025-01-01T00:00:00Z&limit=50
+func (h *AuditHandler) GetAudit(c *gin.Context) {
+	filter := Filter{
+		Actor:     c.Query("actor"),
+		Action:    c.Query("action"),
+		Target:    c.Query("target"),
+		Outcome:   c.Query("outcome"),
+		RequestId: c.Query("requestId"),
+		Cursor:    c.Query("cursor"),
+	}
+
+	var err error
+	if filter.Since, err = parseTime(c.Query("since")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid since: %v", err)})
+		return
+	}
+	if filter.Until, err = parseTime(c.Query("until")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid until: %v", err)})
+		return
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit: must be a positive integer"})
+			return
+		}
+	}
+
+	records, next, err := h.Service.Query(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": records, "nextCursor": next})
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
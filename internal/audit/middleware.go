@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderRequestId carries the ID of a request, echoed in the response.
+const HeaderRequestId = "X-Request-Id"
+
+const (
+	requestIdKey = "audit.requestId"
+	targetKey    = "audit.target"
+)
+
+// RequestID returns a middleware that keeps the X-Request-Id of a request,
+// or assigns a random one, and echoes it in the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestId)
+		if id == "" || len(id) > 128 {
+			id = NewRequestId()
+		}
+		c.Set(requestIdKey, id)
+		c.Header(HeaderRequestId, id)
+		c.Next()
+	}
+}
+
+// RequestIDFrom returns the ID assigned by RequestID.
+func RequestIDFrom(c *gin.Context) string {
+	return c.GetString(requestIdKey)
+}
+
+// NewRequestId returns a random request ID.
+func NewRequestId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// TargetFunc returns what a request acts on.
+type TargetFunc func(c *gin.Context) string
+
+// Param targets the path parameter name.
+func Param(name string) TargetFunc {
+	return func(c *gin.Context) string { return c.Param(name) }
+}
+
+// Query targets the query parameter name, as name=value.
+func Query(name string) TargetFunc {
+	return func(c *gin.Context) string { return name + "=" + c.Query(name) }
+}
+
+// SetTarget sets the target recorded for the request, for handlers whose
+// target is only known once the body is bound.
+func SetTarget(c *gin.Context, target string) {
+	c.Set(targetKey, target)
+}
+
+// Track returns a middleware that records action once the request is
+// handled, including requests rejected by the auth.Guard that follows it.
+// The error is the last one added to the request with c.Error.
+func (s *AuditService) Track(action string, target TargetFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		record := redis.AuditRecord{
+			Time:       start,
+			RequestId:  RequestIDFrom(c),
+			Action:     action,
+			Target:     c.GetString(targetKey),
+			Status:     c.Writer.Status(),
+			Outcome:    OutcomeOf(c.Writer.Status()),
+			RemoteAddr: c.ClientIP(),
+		}
+		if record.Target == "" && target != nil {
+			record.Target = target(c)
+		}
+		if principal, ok := auth.PrincipalFrom(c); ok {
+			record.Actor = principal.Subject
+			record.AuthMethod = principal.Method
+		}
+		if err := c.Errors.Last(); err != nil {
+			record.Error = err.Error()
+		}
+
+		// 요청이 취소되더라도 감사 기록은 남기도록 한다.
+		if _, err := s.Record(context.WithoutCancel(c.Request.Context()), record); err != nil {
+			slog.Error("failed to record audit event", "action", action, "target", record.Target, "requestId", record.RequestId, "err", err)
+		}
+	}
+}
+
+// OutcomeOf returns the outcome of a request answered with the HTTP status.
+func OutcomeOf(status int) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return OutcomeDenied
+	case status == http.StatusMultiStatus:
+		return OutcomePartial
+	case status < http.StatusBadRequest:
+		return OutcomeSuccess
+	default:
+		return OutcomeFailure
+	}
+}
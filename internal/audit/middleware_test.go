@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	"github.com/gin-gonic/gin"
+)
+
+func newAuditedRouter(svc *AuditService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	guard := auth.NewGuardWith(auth.NewTokenAuthenticator([]auth.Credential{
+		{Subject: "ci", Role: auth.RoleOperator, Secret: "op-token"},
+		{Subject: "grafana", Role: auth.RoleReader, Secret: "read-token"},
+	}))
+
+	router := gin.New()
+	router.Use(RequestID())
+	router.POST("/dlq/retry/:key", svc.Track(ActionDLQRetry, Param("key")), guard.Require(auth.PermissionWrite), func(c *gin.Context) {
+		if c.Param("key") == "dlq:menu:broken" {
+			c.Error(errors.New("failed to publish: kafka down"))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{})
+	})
+	return router
+}
+
+func retry(router http.Handler, key, token, requestId string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/dlq/retry/"+key, nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	if requestId != "" {
+		r.Header.Set(HeaderRequestId, requestId)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestTrack_RecordsOutcomes(t *testing.T) {
+	log := &memoryAuditLog{}
+	router := newAuditedRouter(NewService(log))
+
+	if w := retry(router, "dlq:menu:event-1", "op-token", "req-1"); w.Header().Get(HeaderRequestId) != "req-1" {
+		t.Errorf("expected the request ID echoed, got %q", w.Header().Get(HeaderRequestId))
+	}
+	retry(router, "dlq:menu:broken", "op-token", "")
+	retry(router, "dlq:menu:event-2", "read-token", "")
+	retry(router, "dlq:menu:event-3", "", "")
+
+	records, _, err := NewService(log).Query(context.Background(), Filter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("expected 4 records, got %d", len(records))
+	}
+	// 최신순으로 반환된다.
+	anonymous, reader, broken, ok := records[0], records[1], records[2], records[3]
+
+	if ok.Actor != "ci" || ok.AuthMethod != "token" || ok.Action != ActionDLQRetry || ok.Target != "dlq:menu:event-1" ||
+		ok.Outcome != OutcomeSuccess || ok.Status != http.StatusOK || ok.RequestId != "req-1" || ok.Time.IsZero() {
+		t.Errorf("unexpected record of a successful retry: %+v", ok)
+	}
+	if broken.Outcome != OutcomeFailure || broken.Error != "failed to publish: kafka down" || broken.RequestId == "" {
+		t.Errorf("unexpected record of a failed retry: %+v", broken)
+	}
+	if reader.Actor != "grafana" || reader.Outcome != OutcomeDenied || reader.Status != http.StatusForbidden {
+		t.Errorf("unexpected record of a forbidden retry: %+v", reader)
+	}
+	if anonymous.Actor != "" || anonymous.Outcome != OutcomeDenied || anonymous.Status != http.StatusUnauthorized {
+		t.Errorf("unexpected record of an unauthenticated retry: %+v", anonymous)
+	}
+}
@@ -0,0 +1,15 @@
+package audit
+
+import (
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the audit log route, which requires the read
+// permission.
+func RegisterRoutes(router *gin.Engine, guard *auth.Guard, service *AuditService) {
+	handler := NewHandler(service)
+
+	router.GET(poller.HoBomEventProcessorInternalApiPrefix+"/audit", guard.Require(auth.PermissionRead), handler.GetAudit)
+}
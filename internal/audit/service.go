@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+)
+
+// Actions recorded for the management API routes.
+const (
+	ActionDLQRetry      = "dlq.retry"
+	ActionDLQRetryBatch = "dlq.retry_batch"
+	ActionDLQDelete     = "dlq.delete"
+	ActionDLQPurge      = "dlq.purge"
+	ActionDLQExport     = "dlq.export"
+	ActionDLQImport     = "dlq.import"
+	ActionDLQEdit       = "dlq.edit"
+	ActionPollerPause   = "poller.pause"
+	ActionPollerResume  = "poller.resume"
+	ActionPollerTrigger = "poller.trigger"
+)
+
+// Outcomes of a recorded action.
+const (
+	OutcomeSuccess = "success"
+	// OutcomePartial is a batch action that failed for some of its targets.
+	OutcomePartial = "partial"
+	OutcomeFailure = "failure"
+	// OutcomeDenied is a request rejected for missing credentials or
+	// permissions.
+	OutcomeDenied = "denied"
+)
+
+const (
+	defaultQueryLimit = 100
+	maxQueryLimit     = 1000
+)
+
+// Filter selects audit records. Empty fields match every record.
+type Filter struct {
+	Actor  string
+	Action string
+	// Target matches records whose target starts with it.
+	Target    string
+	Outcome   string
+	RequestId string
+	Since     time.Time
+	Until     time.Time
+	// Cursor continues a previous query from the nextCursor it returned.
+	Cursor string
+	// Limit caps the records returned, 100 by default and at most 1000.
+	Limit int
+}
+
+func (f Filter) matches(r redis.AuditRecord) bool {
+	return (f.Actor == "" || r.Actor == f.Actor) &&
+		(f.Action == "" || r.Action == f.Action) &&
+		(f.Target == "" || strings.HasPrefix(r.Target, f.Target)) &&
+		(f.Outcome == "" || r.Outcome == f.Outcome) &&
+		(f.RequestId == "" || r.RequestId == f.RequestId)
+}
+
+type AuditService struct {
+	log redis.AuditLog
+}
+
+// NewService creates an AuditService that appends to and queries log.
+func NewService(log redis.AuditLog) *AuditService {
+	return &AuditService{
+		log: log,
+	}
+}
+
+// Record appends record to the audit log, stamping it with the current time
+// if it has none.
+func (s *AuditService) Record(ctx context.Context, record redis.AuditRecord) (string, error) {
+	if record.Time.IsZero() {
+		record.Time = time.Now()
+	}
+	id, err := s.log.Append(ctx, record)
+	if err != nil {
+		return "", fmt.Errorf("failed to append audit record: %w", err)
+	}
+	return id, nil
+}
+
+// Query returns the records matching f, newest first, and the cursor of the
+// next page, which is empty once no older records are left.
+func (s *AuditService) Query(ctx context.Context, f Filter) ([]redis.AuditRecord, string, error) {
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	limit = min(limit, maxQueryLimit)
+
+	// 시간 범위 외의 조건은 Redis 에서 거를 수 없으므로, 페이지 단위로 읽으며 걸러낸다.
+	matched := make([]redis.AuditRecord, 0, limit)
+	cursor := f.Cursor
+	for {
+		page, err := s.log.Range(ctx, redis.AuditRange{Since: f.Since, Until: f.Until, Before: cursor, Count: limit})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read audit log: %w", err)
+		}
+		for _, record := range page {
+			cursor = record.Id
+			if !f.matches(record) {
+				continue
+			}
+			matched = append(matched, record)
+			if len(matched) == limit {
+				return matched, cursor, nil
+			}
+		}
+		if len(page) < limit {
+			return matched, "", nil
+		}
+	}
+}
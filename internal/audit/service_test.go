@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+)
+
+// memoryAuditLog is an in-memory AuditLog whose Ids are sequence numbers.
+type memoryAuditLog struct {
+	mu      sync.Mutex
+	records []redis.AuditRecord
+}
+
+func (l *memoryAuditLog) Append(_ context.Context, record redis.AuditRecord) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	record.Id = fmt.Sprintf("%06d", len(l.records)+1)
+	l.records = append(l.records, record)
+	return record.Id, nil
+}
+
+func (l *memoryAuditLog) Range(_ context.Context, r redis.AuditRange) ([]redis.AuditRecord, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var selected []redis.AuditRecord
+	for i := len(l.records) - 1; i >= 0; i-- {
+		record := l.records[i]
+		if (r.Before != "" && record.Id >= r.Before) ||
+			(!r.Since.IsZero() && record.Time.Before(r.Since)) ||
+			(!r.Until.IsZero() && record.Time.After(r.Until)) {
+			continue
+		}
+		selected = append(selected, record)
+		if len(selected) == r.Count {
+			break
+		}
+	}
+	return selected, nil
+}
+
+func TestQuery_FiltersNewestFirst(t *testing.T) {
+	log := &memoryAuditLog{}
+	svc := NewService(log)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 10 {
+		action := ActionDLQRetry
+		if i%2 == 1 {
+			action = ActionDLQDelete
+		}
+		svc.Record(context.Background(), redis.AuditRecord{
+			Time:   start.Add(time.Duration(i) * time.Hour),
+			Actor:  "ci",
+			Action: action,
+			Target: fmt.Sprintf("dlq:menu:event-%d", i),
+		})
+	}
+
+	records, next, err := svc.Query(context.Background(), Filter{Action: ActionDLQDelete, Since: start.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next != "" {
+		t.Errorf("expected no next cursor, got %q", next)
+	}
+	var targets []string
+	for _, r := range records {
+		targets = append(targets, r.Target)
+	}
+	if fmt.Sprint(targets) != "[dlq:menu:event-9 dlq:menu:event-7 dlq:menu:event-5 dlq:menu:event-3]" {
+		t.Errorf("expected deletes since 02:00 newest first, got %v", targets)
+	}
+}
+
+func TestQuery_PagesWithCursor(t *testing.T) {
+	log := &memoryAuditLog{}
+	svc := NewService(log)
+	for i := range 5 {
+		svc.Record(context.Background(), redis.AuditRecord{Action: ActionPollerPause, Target: fmt.Sprintf("poller-%d", i)})
+	}
+	// 조건에 맞지 않는 기록이 섞여 있어도 다음 페이지에서 이어서 조회되어야 한다.
+	svc.Record(context.Background(), redis.AuditRecord{Action: ActionDLQPurge, Target: "prefix=dlq:log:"})
+
+	first, next, err := svc.Query(context.Background(), Filter{Action: ActionPollerPause, Limit: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first) != 3 || first[0].Target != "poller-4" || next == "" {
+		t.Fatalf("expected the 3 newest pauses and a cursor, got %+v, %q", first, next)
+	}
+
+	second, next, err := svc.Query(context.Background(), Filter{Action: ActionPollerPause, Limit: 3, Cursor: next})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(second) != 2 || second[0].Target != "poller-1" || next != "" {
+		t.Errorf("expected the 2 remaining pauses and no cursor, got %+v, %q", second, next)
+	}
+}
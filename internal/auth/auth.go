@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Permission is what a route of the internal management API lets a caller do.
+type Permission string
+
+const (
+	// PermissionRead lets a caller inspect DLQ entries and poller status.
+	PermissionRead Permission = "read"
+	// PermissionWrite lets a caller replay, delete and purge DLQ entries and
+	// pause, resume and trigger pollers.
+	PermissionWrite Permission = "write"
+)
+
+// Role is the set of permissions granted to a caller.
+type Role string
+
+const (
+	// RoleReader may only read.
+	RoleReader Role = "reader"
+	// RoleOperator may read and write.
+	RoleOperator Role = "operator"
+)
+
+// ParseRole parses a role name as used in credentials and JWT claims.
+func ParseRole(s string) (Role, error) {
+	switch Role(strings.ToLower(strings.TrimSpace(s))) {
+	case RoleReader:
+		return RoleReader, nil
+	case RoleOperator:
+		return RoleOperator, nil
+	default:
+		return "", fmt.Errorf("unknown role %q", s)
+	}
+}
+
+// Allows reports whether the role grants p.
+func (r Role) Allows(p Permission) bool {
+	switch r {
+	case RoleOperator:
+		return p == PermissionRead || p == PermissionWrite
+	case RoleReader:
+		return p == PermissionRead
+	default:
+		return false
+	}
+}
+
+// Principal is an authenticated caller.
+type Principal struct {
+	Subject string `json:"subject"`
+	Role    Role   `json:"role"`
+	// Method is the scheme that authenticated the caller: token, hmac or jwt.
+	Method string `json:"method"`
+}
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request
+	// carries no credentials of its scheme.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned by an Authenticator when the request
+	// carries credentials of its scheme that do not verify.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Authenticator verifies the credentials of one scheme.
+type Authenticator interface {
+	// Authenticate returns the caller of r. It returns ErrNoCredentials when
+	// r carries no credentials it recognises, and an error wrapping
+	// ErrInvalidCredentials when they do not verify.
+	Authenticate(r *http.Request) (Principal, error)
+}
+
+// Credential is a secret issued to a subject with a role: a bearer token or
+// an HMAC signing key, whose key ID is the subject.
+type Credential struct {
+	Subject string
+	Role    Role
+	Secret  string
+}
+
+// ParseCredentials parses a comma-separated list of subject:role:secret
+// entries, e.g. "ci:operator:s3cr3t,grafana:reader:t0k3n". The secret may
+// itself contain colons.
+func ParseCredentials(s string) ([]Credential, error) {
+	var credentials []Credential
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid credential %q, expected subject:role:secret", redact(entry))
+		}
+		role, err := ParseRole(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("credential %s: %w", parts[0], err)
+		}
+		credentials = append(credentials, Credential{Subject: parts[0], Role: role, Secret: parts[2]})
+	}
+	return credentials, nil
+}
+
+// redact keeps the subject of a malformed credential entry for error
+// messages and drops the rest, which may hold a secret.
+func redact(entry string) string {
+	subject, _, _ := strings.Cut(entry, ":")
+	return subject + ":***"
+}
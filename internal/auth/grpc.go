@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type principalContextKey struct{}
+
+// AuthenticateMetadata authenticates a gRPC call by the bearer token or JWT
+// in its authorization metadata. HMAC-signed requests are only supported
+// over HTTP.
+func (g *Guard) AuthenticateMetadata(ctx context.Context) (Principal, error) {
+	r := &http.Request{Header: http.Header{}, URL: &url.URL{}}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("authorization") {
+			r.Header.Add("Authorization", v)
+		}
+	}
+	return g.authenticate(r)
+}
+
+// WithPrincipal returns a context carrying the authenticated caller of a
+// gRPC call.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller stored by WithPrincipal.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
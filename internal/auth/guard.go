@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// principalKey is the gin context key of the authenticated Principal.
+const principalKey = "auth.principal"
+
+// Config selects the authentication schemes of the internal management API.
+// Every configured scheme is accepted; a request is rejected when none is
+// configured, unless Disabled is set.
+type Config struct {
+	// Disabled lets every request through as an anonymous operator. Only
+	// meant for local development.
+	Disabled bool
+
+	// Tokens are accepted as static bearer tokens.
+	Tokens []Credential
+	// HMACKeys sign requests, identified by their subject as key ID.
+	HMACKeys []Credential
+	// MaxClockSkew is how far the timestamp of a signed request may be from
+	// now.
+	MaxClockSkew time.Duration
+	// MaxSignedBody is the largest body of a signed request that is read to
+	// verify its signature, on routes without a LimitBody.
+	MaxSignedBody int64
+
+	// JWKSFile is the path of a JWKS whose keys sign accepted bearer JWTs.
+	JWKSFile string
+	JWT      JWTConfig
+}
+
+// DefaultConfig returns a Config with no credentials and:
+//   - MaxClockSkew: 5m
+//   - MaxSignedBody: 1MiB
+//   - JWT.RoleClaim: roles
+//   - JWT.Leeway: 30s
+func DefaultConfig() Config {
+	return Config{
+		MaxClockSkew:  5 * time.Minute,
+		MaxSignedBody: 1 << 20,
+		JWT: JWTConfig{
+			RoleClaim: "roles",
+			Leeway:    30 * time.Second,
+		},
+	}
+}
+
+// ConfigFromEnv returns DefaultConfig overridden by:
+//   - AUTH_DISABLED: true to disable authentication
+//   - AUTH_TOKENS: bearer tokens as subject:role:token,...
+//   - AUTH_HMAC_KEYS: signing keys as keyId:role:secret,...
+//   - AUTH_JWKS_FILE, AUTH_JWT_ISSUER, AUTH_JWT_AUDIENCE, AUTH_JWT_ROLE_CLAIM
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+	cfg.Disabled = os.Getenv("AUTH_DISABLED") == "true"
+
+	var err error
+	if cfg.Tokens, err = ParseCredentials(os.Getenv("AUTH_TOKENS")); err != nil {
+		return Config{}, fmt.Errorf("AUTH_TOKENS: %w", err)
+	}
+	if cfg.HMACKeys, err = ParseCredentials(os.Getenv("AUTH_HMAC_KEYS")); err != nil {
+		return Config{}, fmt.Errorf("AUTH_HMAC_KEYS: %w", err)
+	}
+
+	cfg.JWKSFile = os.Getenv("AUTH_JWKS_FILE")
+	cfg.JWT.Issuer = os.Getenv("AUTH_JWT_ISSUER")
+	cfg.JWT.Audience = os.Getenv("AUTH_JWT_AUDIENCE")
+	if claim := os.Getenv("AUTH_JWT_ROLE_CLAIM"); claim != "" {
+		cfg.JWT.RoleClaim = claim
+	}
+	return cfg, nil
+}
+
+// Guard authenticates requests to the internal management API and checks
+// that the role of the caller allows the route.
+type Guard struct {
+	authenticators []Authenticator
+	disabled       bool
+}
+
+// NewGuard creates a Guard accepting every scheme configured in cfg.
+func NewGuard(cfg Config) (*Guard, error) {
+	if cfg.Disabled {
+		return &Guard{disabled: true}, nil
+	}
+
+	var authenticators []Authenticator
+	if len(cfg.Tokens) > 0 {
+		authenticators = append(authenticators, NewTokenAuthenticator(cfg.Tokens))
+	}
+	if len(cfg.HMACKeys) > 0 {
+		authenticators = append(authenticators, NewHMACAuthenticator(cfg.HMACKeys, cfg.MaxClockSkew, cfg.MaxSignedBody))
+	}
+	if cfg.JWKSFile != "" {
+		jwt, err := NewJWTAuthenticator(cfg.JWKSFile, cfg.JWT)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, jwt)
+	}
+	return NewGuardWith(authenticators...), nil
+}
+
+// NewGuardWith creates a Guard accepting the given authenticators, tried in
+// order. A Guard without authenticators rejects every request.
+func NewGuardWith(authenticators ...Authenticator) *Guard {
+	return &Guard{authenticators: authenticators}
+}
+
+// Require returns a middleware that rejects requests without valid
+// credentials with 401 and requests whose role does not grant p with 403.
+// The Principal of an authenticated request, accepted or not, is available
+// via PrincipalFrom. Rejections are added to the request with c.Error.
+func (g *Guard) Require(p Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := g.authenticate(c.Request)
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.Error(err)
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit)})
+			return
+		}
+		if err != nil {
+			// 어떤 Credential 이 왜 거부되었는지는 응답에 노출하지 않고 로그로만 남긴다.
+			slog.Warn("rejected internal API request", "method", c.Request.Method, "path", c.FullPath(), "err", err)
+			c.Error(err)
+			c.Header("WWW-Authenticate", `Bearer realm="hobom-event-processor"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			return
+		}
+		// 권한이 없는 요청도 누가 시도했는지 알 수 있도록 Principal 을 남긴다.
+		c.Set(principalKey, principal)
+		if !principal.Role.Allows(p) {
+			err := fmt.Errorf("role %s does not grant %s access", principal.Role, p)
+			c.Error(err)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		c.Next()
+	}
+}
+
+// LimitBody returns a middleware that bounds the request body to n bytes.
+// Placed before Require, it also replaces MaxSignedBody as the limit of the
+// body read to verify a signature, so routes taking large bodies can still be
+// signed.
+func LimitBody(n int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, n)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), bodyLimitKey{}, n))
+		c.Next()
+	}
+}
+
+// authenticate returns the Principal of the first authenticator that
+// accepts r.
+func (g *Guard) authenticate(r *http.Request) (Principal, error) {
+	if g.disabled {
+		return Principal{Subject: "anonymous", Role: RoleOperator, Method: "none"}, nil
+	}
+	var rejected []error
+	for _, a := range g.authenticators {
+		principal, err := a.Authenticate(r)
+		if err == nil {
+			return principal, nil
+		}
+		if !errors.Is(err, ErrNoCredentials) {
+			rejected = append(rejected, err)
+		}
+	}
+	if len(rejected) > 0 {
+		return Principal{}, errors.Join(rejected...)
+	}
+	return Principal{}, ErrNoCredentials
+}
+
+// PrincipalFrom returns the caller authenticated by Require, also when its
+// role was denied.
+func PrincipalFrom(c *gin.Context) (Principal, bool) {
+	v, ok := c.Get(principalKey)
+	if !ok {
+		return Principal{}, false
+	}
+	principal, ok := v.(Principal)
+	return principal, ok
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newRouter serves GET /read and POST /write behind g and echoes the
+// authenticated subject.
+func newRouter(g *Guard) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	echo := func(c *gin.Context) {
+		principal, _ := PrincipalFrom(c)
+		c.String(http.StatusOK, principal.Subject)
+	}
+	router.GET("/read", g.Require(PermissionRead), echo)
+	router.POST("/write", g.Require(PermissionWrite), echo)
+	return router
+}
+
+func serve(router http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func withBearer(r *http.Request, token string) *http.Request {
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func TestGuard_TokenRoles(t *testing.T) {
+	credentials, err := ParseCredentials("ci:operator:op-token, grafana:reader:read-token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	router := newRouter(NewGuardWith(NewTokenAuthenticator(credentials)))
+
+	cases := []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"reader reads", withBearer(httptest.NewRequest(http.MethodGet, "/read", nil), "read-token"), http.StatusOK},
+		{"reader cannot write", withBearer(httptest.NewRequest(http.MethodPost, "/write", nil), "read-token"), http.StatusForbidden},
+		{"operator writes", withBearer(httptest.NewRequest(http.MethodPost, "/write", nil), "op-token"), http.StatusOK},
+		{"unknown token", withBearer(httptest.NewRequest(http.MethodGet, "/read", nil), "guess"), http.StatusUnauthorized},
+		{"no credentials", httptest.NewRequest(http.MethodGet, "/read", nil), http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if w := serve(router, tc.req); w.Code != tc.status {
+				t.Errorf("expected %d, got %d: %s", tc.status, w.Code, w.Body.String())
+			}
+		})
+	}
+
+	if w := serve(router, withBearer(httptest.NewRequest(http.MethodPost, "/write", nil), "op-token")); w.Body.String() != "ci" {
+		t.Errorf("expected the principal of the token, got %q", w.Body.String())
+	}
+}
+
+func TestGuard_RejectsEverythingWithoutAuthenticators(t *testing.T) {
+	g, err := NewGuard(DefaultConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if w := serve(newRouter(g), httptest.NewRequest(http.MethodGet, "/read", nil)); w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestGuard_Disabled(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Disabled = true
+	g, err := NewGuard(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if w := serve(newRouter(g), httptest.NewRequest(http.MethodPost, "/write", nil)); w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+}
+
+func TestParseCredentials(t *testing.T) {
+	credentials, err := ParseCredentials("ci:Operator:a:b:c")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(credentials) != 1 || credentials[0] != (Credential{Subject: "ci", Role: RoleOperator, Secret: "a:b:c"}) {
+		t.Errorf("unexpected credentials: %+v", credentials)
+	}
+
+	for _, s := range []string{"ci:operator", "ci:admin:secret", ":reader:secret"} {
+		if _, err := ParseCredentials(s); err == nil {
+			t.Errorf("expected %q rejected", s)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHMACAuthenticator(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	a := NewHMACAuthenticator([]Credential{{Subject: "cli", Role: RoleOperator, Secret: "s3cr3t"}}, 5*time.Minute, 1<<20)
+	a.now = func() time.Time { return now }
+
+	signed := func(secret string, at time.Time) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/dlq/retry?dry=1", strings.NewReader(`{"keys":["dlq:log:1"]}`))
+		if err := SignRequest(r, "cli", secret, at); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return r
+	}
+
+	r := signed("s3cr3t", now.Add(-time.Minute))
+	principal, err := a.Authenticate(r)
+	if err != nil || principal.Subject != "cli" || principal.Role != RoleOperator {
+		t.Fatalf("expected the signed request accepted, got %+v, %v", principal, err)
+	}
+	if body, _ := io.ReadAll(r.Body); string(body) != `{"keys":["dlq:log:1"]}` {
+		t.Errorf("expected the body restored, got %q", body)
+	}
+
+	tampered := signed("s3cr3t", now)
+	tampered.URL.RawQuery = "dry=0"
+	if _, err := a.Authenticate(tampered); err == nil {
+		t.Error("expected a request with a changed URI rejected")
+	}
+	if _, err := a.Authenticate(signed("wrong", now)); err == nil {
+		t.Error("expected a request signed with another secret rejected")
+	}
+	if _, err := a.Authenticate(signed("s3cr3t", now.Add(-10*time.Minute))); err == nil {
+		t.Error("expected a stale request rejected")
+	}
+	if _, err := a.Authenticate(httptest.NewRequest(http.MethodGet, "/dlq", nil)); err != ErrNoCredentials {
+		t.Errorf("expected ErrNoCredentials for an unsigned request, got %v", err)
+	}
+}
+
+func TestHMACAuthenticator_LimitsTheSignedBody(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	a := NewHMACAuthenticator([]Credential{{Subject: "cli", Role: RoleOperator, Secret: "s3cr3t"}}, 5*time.Minute, 8)
+	a.now = func() time.Time { return now }
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	g := NewGuardWith(a)
+	echo := func(c *gin.Context) { c.String(http.StatusOK, "ok") }
+	router.POST("/small", g.Require(PermissionWrite), echo)
+	router.POST("/import", LimitBody(64), g.Require(PermissionWrite), echo)
+
+	signed := func(path, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if err := SignRequest(r, "cli", "s3cr3t", now); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return r
+	}
+
+	cases := []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"within the default limit", signed("/small", "12345678"), http.StatusOK},
+		{"over the default limit", signed("/small", "123456789"), http.StatusRequestEntityTooLarge},
+		{"within the route limit", signed("/import", strings.Repeat("x", 64)), http.StatusOK},
+		{"over the route limit", signed("/import", strings.Repeat("x", 65)), http.StatusRequestEntityTooLarge},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if w := serve(router, tc.req); w.Code != tc.status {
+				t.Errorf("expected %d, got %d: %s", tc.status, w.Code, w.Body.String())
+			}
+		})
+	}
+
+	var tooLarge *http.MaxBytesError
+	if _, err := a.Authenticate(signed("/small", "123456789")); !errors.As(err, &tooLarge) {
+		t.Errorf("expected *http.MaxBytesError, got %v", err)
+	}
+}
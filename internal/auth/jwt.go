@@ -0,0 +1,307 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// JWTConfig holds the claims a JWT must carry.
+type JWTConfig struct {
+	// Issuer must equal the iss claim when set.
+	Issuer string
+	// Audience must be one of the aud claim values when set.
+	Audience string
+	// RoleClaim names the claim holding the role of the caller, either a
+	// string or an array of strings of which the most privileged role wins.
+	RoleClaim string
+	// Leeway tolerates clock drift when checking exp and nbf.
+	Leeway time.Duration
+}
+
+// JWTAuthenticator accepts bearer JWTs signed with RS256, RS384, RS512,
+// ES256 or ES384 by a key of a JWKS.
+type JWTAuthenticator struct {
+	keys map[string]crypto.PublicKey
+	// kid 이 없는 Token 은 JWKS 에 Key 가 하나뿐일 때만 허용한다.
+	only crypto.PublicKey
+	cfg  JWTConfig
+	now  func() time.Time
+}
+
+// NewJWTAuthenticator loads the JWKS stored in the file at path.
+func NewJWTAuthenticator(path string, cfg JWTConfig) (*JWTAuthenticator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS %s: %w", path, err)
+	}
+	a := &JWTAuthenticator{keys: keys, cfg: cfg, now: time.Now}
+	if len(keys) == 1 {
+		for _, key := range keys {
+			a.only = key
+		}
+	}
+	return a, nil
+}
+
+// Authenticate verifies the bearer JWT of r.
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (Principal, error) {
+	token, ok := bearerToken(r)
+	if !ok || !looksLikeJWT(token) {
+		return Principal{}, ErrNoCredentials
+	}
+	claims, err := a.verify(token)
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	principal, err := a.principal(claims)
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return principal, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Issuer    string          `json:"iss"`
+	Subject   string          `json:"sub"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+	raw       map[string]json.RawMessage
+}
+
+// verify checks the signature of token and returns its claims.
+func (a *JWTAuthenticator) verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid header: %w", err)
+	}
+
+	key := a.only
+	if header.Kid != "" {
+		key = a.keys[header.Kid]
+	}
+	if key == nil {
+		return jwtClaims{}, fmt.Errorf("unknown key %q", header.Kid)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return jwtClaims{}, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid claims: %w", err)
+	}
+	if err := decodeSegment(parts[1], &claims.raw); err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid claims: %w", err)
+	}
+	return claims, nil
+}
+
+// principal checks the registered claims and reads the role claim.
+func (a *JWTAuthenticator) principal(claims jwtClaims) (Principal, error) {
+	now := a.now()
+	if claims.ExpiresAt == nil {
+		return Principal{}, errors.New("missing exp claim")
+	}
+	if now.After(unixTime(*claims.ExpiresAt).Add(a.cfg.Leeway)) {
+		return Principal{}, errors.New("token expired")
+	}
+	if claims.NotBefore != nil && now.Add(a.cfg.Leeway).Before(unixTime(*claims.NotBefore)) {
+		return Principal{}, errors.New("token not valid yet")
+	}
+	if a.cfg.Issuer != "" && claims.Issuer != a.cfg.Issuer {
+		return Principal{}, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if a.cfg.Audience != "" && !slices.Contains(stringOrList(claims.Audience), a.cfg.Audience) {
+		return Principal{}, fmt.Errorf("audience %q not granted", a.cfg.Audience)
+	}
+
+	var role Role
+	for _, name := range stringOrList(claims.raw[a.cfg.RoleClaim]) {
+		r, err := ParseRole(name)
+		if err != nil {
+			continue
+		}
+		if role == "" || r == RoleOperator {
+			role = r
+		}
+	}
+	if role == "" {
+		return Principal{}, fmt.Errorf("no known role in claim %q", a.cfg.RoleClaim)
+	}
+	return Principal{Subject: claims.Subject, Role: role, Method: "jwt"}, nil
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed string, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return fmt.Errorf("alg %s does not match an RSA key", alg)
+		}
+		if err := rsa.VerifyPKCS1v15(key, hash, digest, signature); err != nil {
+			return errors.New("signature mismatch")
+		}
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if !strings.HasPrefix(alg, "ES") || len(signature) != 2*size {
+			return fmt.Errorf("alg %s does not match an EC key", alg)
+		}
+		// JWS 의 ECDSA 서명은 ASN.1 이 아닌 r || s 형식이다.
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("signature mismatch")
+		}
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS returns the RSA and EC signing keys of a JWKS by key ID.
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys")
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid key parameter %q", s)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// stringOrList decodes a claim that is either a string or an array of
+// strings.
+func stringOrList(raw json.RawMessage) []string {
+	var one string
+	if json.Unmarshal(raw, &one) == nil {
+		return []string{one}
+	}
+	var many []string
+	json.Unmarshal(raw, &many)
+	return many
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var b64 = base64.RawURLEncoding
+
+// writeJWKS stores a JWKS with an RSA key "rsa" and a P-256 key "ec".
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	t.Helper()
+	jwks := map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64.EncodeToString(rsaKey.N.Bytes()), "e": b64.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64.EncodeToString(ecKey.X.FillBytes(make([]byte, 32))), "y": b64.EncodeToString(ecKey.Y.FillBytes(make([]byte, 32)))},
+	}}
+	data, _ := json.Marshal(jwks)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+	return path
+}
+
+func signJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]any) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := b64.EncodeToString(header) + "." + b64.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		signature, _ = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		r, s, _ := ecdsa.Sign(rand.Reader, key, digest[:])
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + b64.EncodeToString(signature)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	cfg := DefaultConfig().JWT
+	cfg.Issuer = "https://sso.hobom.dev"
+	cfg.Audience = "hobom-event-processor"
+	a, err := NewJWTAuthenticator(writeJWKS(t, rsaKey, ecKey), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{"iss": cfg.Issuer, "aud": []string{"other", cfg.Audience}, "sub": "alice", "exp": exp, "roles": []string{"viewer", "reader"}}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+	authenticate := func(token string) (Principal, error) {
+		return a.Authenticate(withBearer(httptest.NewRequest(http.MethodGet, "/dlq", nil), token))
+	}
+
+	if p, err := authenticate(signJWT(t, "RS256", "rsa", rsaKey, claims(nil))); err != nil || p.Subject != "alice" || p.Role != RoleReader {
+		t.Errorf("expected an RS256 reader token accepted, got %+v, %v", p, err)
+	}
+	if p, err := authenticate(signJWT(t, "ES256", "ec", ecKey, claims(map[string]any{"roles": []string{"reader", "operator"}}))); err != nil || p.Role != RoleOperator {
+		t.Errorf("expected an ES256 operator token accepted, got %+v, %v", p, err)
+	}
+
+	rejected := map[string]string{
+		"expired":        signJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()})),
+		"wrong audience": signJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"aud": "other"})),
+		"wrong issuer":   signJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"iss": "https://evil"})),
+		"no role":        signJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"roles": []string{"viewer"}})),
+		"foreign key":    signJWT(t, "RS256", "rsa", otherKey, claims(nil)),
+		"alg mismatch":   signJWT(t, "ES256", "rsa", ecKey, claims(nil)),
+	}
+	for name, token := range rejected {
+		if _, err := authenticate(token); err == nil || err == ErrNoCredentials {
+			t.Errorf("%s: expected invalid credentials, got %v", name, err)
+		}
+	}
+
+	if _, err := authenticate("static-token"); err != ErrNoCredentials {
+		t.Errorf("expected a non-JWT bearer token left to other schemes, got %v", err)
+	}
+}
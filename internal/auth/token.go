@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TokenAuthenticator accepts static bearer tokens.
+type TokenAuthenticator struct {
+	// 요청마다 Token 을 직접 비교하지 않도록, Token 의 SHA-256 Hash 로 조회한다.
+	principals map[[sha256.Size]byte]Principal
+}
+
+// NewTokenAuthenticator accepts the secrets of credentials as bearer tokens.
+func NewTokenAuthenticator(credentials []Credential) *TokenAuthenticator {
+	principals := make(map[[sha256.Size]byte]Principal, len(credentials))
+	for _, c := range credentials {
+		principals[sha256.Sum256([]byte(c.Secret))] = Principal{Subject: c.Subject, Role: c.Role, Method: "token"}
+	}
+	return &TokenAuthenticator{principals: principals}
+}
+
+// Authenticate looks up the token of an "Authorization: Bearer" header.
+// Tokens shaped like a JWT are left to the JWTAuthenticator.
+func (a *TokenAuthenticator) Authenticate(r *http.Request) (Principal, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return Principal{}, ErrNoCredentials
+	}
+	if principal, ok := a.principals[sha256.Sum256([]byte(token))]; ok {
+		return principal, nil
+	}
+	if looksLikeJWT(token) {
+		return Principal{}, ErrNoCredentials
+	}
+	return Principal{}, fmt.Errorf("%w: unknown bearer token", ErrInvalidCredentials)
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,82 @@
+package dlq
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+)
+
+var (
+	// ErrInvalidEdit is returned by EditDLQ for a payload that does not pass
+	// the schema of the entry's event type, or for an entry that cannot be
+	// edited.
+	ErrInvalidEdit = errors.New("invalid DLQ edit")
+	// ErrVersionConflict is returned by EditDLQ when the entry was edited
+	// since the version the caller based its edit on.
+	ErrVersionConflict = errors.New("DLQ entry was edited concurrently")
+)
+
+// Edit is a new payload for a DLQ entry.
+type Edit struct {
+	Payload json.RawMessage
+	// Version is the version the edit is based on. When set, the edit is
+	// rejected with ErrVersionConflict if the entry holds another version.
+	Version *int
+	// Editor is who made the edit, recorded in the entry's history.
+	Editor string
+}
+
+// EditDLQ replaces the payload of the entry stored under key, keeping the
+// replaced version in the entry's history, and returns the edited entry. The
+// payload is validated against the schema of the entry's event type; binary
+// entries cannot be edited. The entry keeps its remaining TTL.
+//
+// The version check and the write are one atomic update, so of two edits
+// based on the same version only the first one is stored.
+func (s *DLQService) EditDLQ(ctx context.Context, key string, edit Edit) (poller.DLQEntry, error) {
+	var entry poller.DLQEntry
+	err := s.redisDLQ.Update(ctx, key, func(data []byte) ([]byte, error) {
+		var err error
+		if entry, err = poller.DecodeDLQEntry(data); err != nil {
+			return nil, err
+		}
+
+		if entry.Payload == nil {
+			return nil, fmt.Errorf("%w: %s holds a binary value", ErrInvalidEdit, key)
+		}
+		if edit.Version != nil && *edit.Version != entry.Version {
+			return nil, fmt.Errorf("%w: %s is at version %d, not %d", ErrVersionConflict, key, entry.Version, *edit.Version)
+		}
+		// Envelope 도입 이전에 저장된 Entry 는 Topic 이 없으므로, DLQ Key 로부터 복원한다.
+		if err := poller.ValidateSchema(cmp.Or(entry.Topic, inferTopicFromKey(key)), edit.Payload); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidEdit, err)
+		}
+
+		entry.Edit(edit.Payload, edit.Editor, time.Now().UTC())
+		value, err := json.Marshal(entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal DLQ entry: %w", err)
+		}
+		return value, nil
+	})
+	if err != nil {
+		return poller.DLQEntry{}, fmt.Errorf("failed to edit DLQ: %w", err)
+	}
+	return entry, nil
+}
+
+// History returns every version of the entry stored under key, oldest
+// first and ending with the current one. The payload of binary entries,
+// which cannot be edited, is null.
+func (s *DLQService) History(ctx context.Context, key string) ([]poller.DLQRevision, error) {
+	entry, err := s.GetDLQEntry(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return append(entry.History, entry.Revision()), nil
+}
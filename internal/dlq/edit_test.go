@@ -0,0 +1,179 @@
+package dlq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+)
+
+const invalidMessage = `{"key":"event-abc","topic":"hobom.messages","payload":{"type":"MAIL_MESSAGE","title":"hi","recipient":""},"reason":"invalid MESSAGE payload: recipient must not be empty"}`
+
+func TestEditDLQ_StoresNewVersionAndReplaysIt(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:invalid:menu:event-abc", []byte(invalidMessage), 5*time.Hour)
+	pub := &mockKafkaPublisher{}
+	svc := NewService(store, pub, &mockPatchClient{})
+
+	version := 0
+	entry, err := svc.EditDLQ(context.Background(), "dlq:invalid:menu:event-abc", Edit{
+		Payload: json.RawMessage(`{"type":"MAIL_MESSAGE","title":"hi","recipient":"user-1"}`),
+		Version: &version,
+		Editor:  "alice",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Version != 1 || entry.EditedBy != "alice" || entry.Reason != "" {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+	if store.ttls["dlq:invalid:menu:event-abc"] != 5*time.Hour {
+		t.Errorf("expected the TTL to be kept, got %v", store.ttls["dlq:invalid:menu:event-abc"])
+	}
+
+	versions, err := svc.History(context.Background(), "dlq:invalid:menu:event-abc")
+	if err != nil || len(versions) != 2 || versions[0].Version != 0 || versions[0].Reason == "" || versions[1].EditedBy != "alice" {
+		t.Fatalf("expected the failed and the edited version, got %+v %v", versions, err)
+	}
+
+	if err := svc.RetryDLQ(context.Background(), "dlq:invalid:menu:event-abc"); err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	var cmd poller.DeliverHoBomMessageCommand
+	if len(pub.published) != 1 || json.Unmarshal(pub.published[0].Value, &cmd) != nil || cmd.Recipient != "user-1" {
+		t.Errorf("expected the edited payload published, got %+v", pub.published)
+	}
+}
+
+func TestEditDLQ_RejectsPayloadFailingTheSchema(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:invalid:menu:event-abc", []byte(invalidMessage), time.Hour)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	for _, payload := range []string{
+		`{"type":"MAIL_MESSAGE","title":"hi","recipent":"user-1"}`,
+		`{"type":"FAX","title":"hi","recipient":"user-1"}`,
+		`not json`,
+	} {
+		if _, err := svc.EditDLQ(context.Background(), "dlq:invalid:menu:event-abc", Edit{Payload: json.RawMessage(payload)}); !errors.Is(err, ErrInvalidEdit) {
+			t.Errorf("%s: expected ErrInvalidEdit, got %v", payload, err)
+		}
+	}
+
+	_, err := svc.EditDLQ(context.Background(), "dlq:invalid:menu:event-abc", Edit{Payload: json.RawMessage(`{"type":"FAX","title":"","recipient":"r"}`)})
+	var verr *poller.ValidationError
+	if !errors.As(err, &verr) || len(verr.Violations) != 2 {
+		t.Errorf("expected the broken rules, got %v", err)
+	}
+	if string(store.data["dlq:invalid:menu:event-abc"]) != invalidMessage {
+		t.Errorf("expected the entry unchanged, got %s", store.data["dlq:invalid:menu:event-abc"])
+	}
+}
+
+func TestEditDLQ_ValidatesLegacyEntriesByKey(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:log:event-1", []byte(`[{"serviceType":"api"}]`), time.Hour)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	if _, err := svc.EditDLQ(context.Background(), "dlq:log:event-1", Edit{Payload: json.RawMessage(`[{"serviceType":"api"}]`)}); !errors.Is(err, ErrInvalidEdit) {
+		t.Errorf("expected the log batch schema to apply, got %v", err)
+	}
+	entry, err := svc.EditDLQ(context.Background(), "dlq:log:event-1", Edit{Payload: json.RawMessage(`[{"serviceType":"api","level":"INFO"}]`)})
+	if err != nil || entry.Version != 1 || string(entry.History[0].Payload) != `[{"serviceType":"api"}]` {
+		t.Errorf("expected the legacy payload kept as version 0, got %+v %v", entry, err)
+	}
+}
+
+func TestEditDLQ_Conflicts(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:invalid:menu:event-abc", []byte(invalidMessage), time.Hour)
+	store.Save(context.Background(), "dlq:log:event-3", []byte(`{"key":"svc","topic":"hobom.logs","value":"AAAAAAE="}`), time.Hour)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+	payload := json.RawMessage(`{"type":"MAIL_MESSAGE","title":"hi","recipient":"user-1"}`)
+
+	stale := 1
+	if _, err := svc.EditDLQ(context.Background(), "dlq:invalid:menu:event-abc", Edit{Payload: payload, Version: &stale}); !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("expected ErrVersionConflict, got %v", err)
+	}
+	if _, err := svc.EditDLQ(context.Background(), "dlq:log:event-3", Edit{Payload: payload}); !errors.Is(err, ErrInvalidEdit) {
+		t.Errorf("expected binary entries to be rejected, got %v", err)
+	}
+	if _, err := svc.EditDLQ(context.Background(), "dlq:menu:gone", Edit{Payload: payload}); !errors.Is(err, redis.ErrDLQNotFound) {
+		t.Errorf("expected ErrDLQNotFound, got %v", err)
+	}
+}
+
+func TestEditDLQ_ConcurrentEditsOfTheSameVersion(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:invalid:menu:event-abc", []byte(invalidMessage), time.Hour)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, recipient := range []string{"user-1", "user-2"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			version := 0
+			_, errs[i] = svc.EditDLQ(context.Background(), "dlq:invalid:menu:event-abc", Edit{
+				Payload: json.RawMessage(`{"type":"MAIL_MESSAGE","title":"hi","recipient":"` + recipient + `"}`),
+				Version: &version,
+				Editor:  recipient,
+			})
+		}()
+	}
+	wg.Wait()
+
+	var conflicts int
+	for _, err := range errs {
+		if errors.Is(err, ErrVersionConflict) {
+			conflicts++
+		} else if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if conflicts != 1 {
+		t.Fatalf("expected exactly one edit to conflict, got %v", errs)
+	}
+	versions, err := svc.History(context.Background(), "dlq:invalid:menu:event-abc")
+	if err != nil || len(versions) != 2 || versions[1].Version != 1 {
+		t.Errorf("expected a single edit stored, got %+v %v", versions, err)
+	}
+}
+
+func TestRetryDLQVersion_SkipsAnEditReplacedInTheMeantime(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:invalid:menu:event-abc", []byte(invalidMessage), time.Hour)
+	pub := &mockKafkaPublisher{}
+	svc := NewService(store, pub, &mockPatchClient{})
+
+	for _, recipient := range []string{"user-1", "user-2"} {
+		if _, err := svc.EditDLQ(context.Background(), "dlq:invalid:menu:event-abc", Edit{
+			Payload: json.RawMessage(`{"type":"MAIL_MESSAGE","title":"hi","recipient":"` + recipient + `"}`),
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := svc.RetryDLQVersion(context.Background(), "dlq:invalid:menu:event-abc", 1); !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("expected ErrVersionConflict for the replaced version, got %v", err)
+	}
+	if len(pub.published) != 0 {
+		t.Fatalf("expected nothing published, got %d events", len(pub.published))
+	}
+	if _, ok := store.data["dlq:invalid:menu:event-abc"]; !ok {
+		t.Fatal("expected the entry kept")
+	}
+
+	if err := svc.RetryDLQVersion(context.Background(), "dlq:invalid:menu:event-abc", 2); err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	if len(pub.published) != 1 {
+		t.Errorf("expected the current version published, got %d events", len(pub.published))
+	}
+}
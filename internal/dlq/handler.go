@@ -1,10 +1,20 @@
 package dlq
 
 import (
+	"cmp"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
 	"github.com/gin-gonic/gin"
 )
 
@@ -45,13 +55,127 @@ func (h *DLQHandler) GetDLQ(c *gin.Context) {
 		return
 	}
 
-	var pretty map[string]interface{}
-	if err := json.Unmarshal(data, &pretty); err != nil {
+	// Message 는 JSON 객체, Log 는 JSON 배열로 저장되므로 두 형태 모두 허용한다.
+	entry, err := poller.DecodeDLQEntry(data)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to parse DLQ JSON"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"item": pretty})
+	// Protobuf, Avro 로 인코딩된 Payload 는 base64 문자열로 반환한다.
+	var item any = entry.Payload
+	if entry.Payload == nil {
+		item = entry.Value
+	}
+	metadata := gin.H{
+		"key":     entry.Key,
+		"topic":   entry.Topic,
+		"headers": entry.Headers,
+		"reason":  entry.Reason,
+		"error":   entry.Error,
+	}
+	if !entry.FailedAt.IsZero() {
+		metadata["failedAt"] = entry.FailedAt
+	}
+	metadata["version"] = entry.Version
+	if entry.Version > 0 {
+		metadata["editedAt"] = entry.EditedAt
+		metadata["editedBy"] = entry.EditedBy
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"item":     item,
+		"metadata": metadata,
+	})
+}
+
+// maxEditBody bounds the JSON body of an edit, enforced by the route's
+// LimitBody.
+const maxEditBody = 1 << 20
+
+// `PUT` /dlq/:key
+// DLQ의 Payload 를 수정하도록 한다. 수정 전의 Payload 는 Entry 의 이력으로 남는다.
+// Payload 는 Event Type 의 스키마로 검증하며, version 을 보내면 그 사이 다른 수정이 있었는지 확인한다.
+// replay=true 라면 수정한 Payload 를 바로 재발행 하도록 한다.
+// ex) ?replay=true {"payload": {...}, "version": 0}
+func (h *DLQHandler) EditDLQ(c *gin.Context) {
+	key := c.Param("key")
+	replay, err := strconv.ParseBool(cmp.Or(c.Query("replay"), "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid replay: must be true or false"})
+		return
+	}
+
+	var req struct {
+		Payload json.RawMessage `json:"payload" binding:"required"`
+		Version *int            `json:"version"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid request: %v", err)})
+		return
+	}
+
+	edit := Edit{Payload: req.Payload, Version: req.Version}
+	if principal, ok := auth.PrincipalFrom(c); ok {
+		edit.Editor = principal.Subject
+	}
+	entry, err := h.Service.EditDLQ(c.Request.Context(), key, edit)
+	if err != nil {
+		c.Error(err)
+		c.JSON(editStatus(err), editError(err))
+		return
+	}
+	audit.SetTarget(c, fmt.Sprintf("%s@v%d", key, entry.Version))
+
+	if !replay {
+		c.JSON(http.StatusOK, gin.H{"key": key, "version": entry.Version, "replayed": false})
+		return
+	}
+	// 재발행에 실패하더라도 수정한 Payload 는 DLQ 에 남아 있으므로, 다시 재발행할 수 있다.
+	// 그 사이 다른 수정이 있었다면, 이 요청이 수정한 버전이 아니므로 재발행하지 않는다.
+	if err := h.Service.RetryDLQVersion(c.Request.Context(), key, entry.Version); err != nil {
+		c.Error(err)
+		c.JSON(editStatus(err), gin.H{"key": key, "version": entry.Version, "replayed": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"key": key, "version": entry.Version, "replayed": true})
+}
+
+// `GET` /dlq/:key/history
+// DLQ Payload 의 모든 버전을 오래된 순서로 가져오도록 한다. 마지막 항목이 현재 버전이다.
+func (h *DLQHandler) GetDLQHistory(c *gin.Context) {
+	key := c.Param("key")
+
+	versions, err := h.Service.History(c.Request.Context(), key)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("DLQ not found: %v", err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"key": key, "items": versions})
+}
+
+func editStatus(err error) int {
+	switch {
+	case errors.Is(err, redis.ErrDLQNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrInvalidEdit):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrVersionConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// editError returns the error body of a rejected edit, listing the broken
+// rules when the payload failed validation.
+func editError(err error) gin.H {
+	body := gin.H{"error": err.Error()}
+	var verr *poller.ValidationError
+	if errors.As(err, &verr) {
+		body["violations"] = verr.Violations
+	}
+	return body
 }
 
 // `POST` /dlq/retry/:key
@@ -60,9 +184,249 @@ func (h *DLQHandler) RetryDLQ(c *gin.Context) {
 	key := c.Param("key")
 
 	if err := h.Service.RetryDLQ(c.Request.Context(), key); err != nil {
+		c.Error(err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "DLQ retried and removed from Redis"})
-}
\ No newline at end of file
+}
+
+// `POST` /dlq/retry
+// 여러 DLQ를 한 번에 재발행 하도록 한다.
+// 트랜잭션 Publisher 를 사용하는 경우, 모든 DLQ가 함께 재발행되거나 모두 재발행되지 않는다.
+// ex) {"keys": ["dlq:log:event-1", "dlq:log:event-2"]}
+func (h *DLQHandler) RetryDLQBatch(c *gin.Context) {
+	var req struct {
+		Keys []string `json:"keys" binding:"required,min=1"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid request: %v", err)})
+		return
+	}
+	audit.SetTarget(c, strings.Join(req.Keys, ","))
+
+	results, err := h.Service.RetryDLQBatch(c.Request.Context(), req.Keys)
+	if err != nil {
+		c.Error(err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	retried := []string{}
+	failed := gin.H{}
+	for _, key := range req.Keys {
+		if err := results[key]; err != nil {
+			failed[key] = err.Error()
+			continue
+		}
+		retried = append(retried, key)
+	}
+
+	status := http.StatusOK
+	if len(failed) > 0 {
+		status = http.StatusMultiStatus
+		c.Error(fmt.Errorf("%d of %d keys failed", len(failed), len(req.Keys)))
+	}
+	c.JSON(status, gin.H{"retried": retried, "failed": failed})
+}
+
+// `DELETE` /dlq/:key
+// DLQ를 재발행 하지 않고 제거하도록 한다.
+func (h *DLQHandler) DeleteDLQ(c *gin.Context) {
+	key := c.Param("key")
+
+	if err := h.Service.DeleteDLQ(c.Request.Context(), key); err != nil {
+		c.Error(err)
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("DLQ not found: %v", err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "DLQ removed from Redis"})
+}
+
+// `DELETE` /dlq
+// prefix에 해당하는 모든 DLQ를 재발행 하지 않고 제거하도록 한다.
+// 실수로 모든 Key 를 제거하지 않도록, prefix는 반드시 `dlq:` 로 시작해야 한다.
+// ex) ?prefix=dlq:invalid:log:
+func (h *DLQHandler) PurgeDLQ(c *gin.Context) {
+	prefix := c.Query("prefix")
+
+	deleted, err := h.Service.PurgeDLQ(c.Request.Context(), prefix)
+	if err != nil {
+		c.Error(err)
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrInvalidPurgePrefix) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error(), "deleted": deleted})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": deleted})
+}
+
+// maxImportBody bounds the NDJSON body of an import.
+const maxImportBody = 64 << 20
+
+// `GET` /dlq/export
+// prefix 또는 key 로 선택한 DLQ를 TTL 과 함께 NDJSON 으로 내려받도록 한다.
+// 한 줄이 하나의 Entry 이며, 조회하는 대로 스트리밍한다.
+// ex) ?prefix=dlq:menu: 또는 ?key=dlq:menu:event-1&key=dlq:log:event-2
+func (h *DLQHandler) ExportDLQ(c *gin.Context) {
+	prefix := c.Query("prefix")
+	keys := c.QueryArray("key")
+	if len(keys) > 0 {
+		audit.SetTarget(c, strings.Join(keys, ","))
+	} else {
+		audit.SetTarget(c, "prefix="+prefix)
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="dlq-export-%s.ndjson"`, time.Now().UTC().Format("20060102T150405Z")))
+	enc := json.NewEncoder(c.Writer)
+	exported, err := h.Service.ExportDLQ(c.Request.Context(), prefix, keys, func(record ExportRecord) error {
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		c.Error(err)
+		// 이미 일부를 내려보냈다면 상태 코드를 바꿀 수 없으므로, 잘린 응답으로 끝낸다.
+		if c.Writer.Written() {
+			slog.Error("DLQ export aborted", "exported", exported, "err", err)
+			return
+		}
+		c.Header("Content-Type", "application/json; charset=utf-8")
+		c.Header("Content-Disposition", "")
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrOutsideDLQ) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	// Entry 가 하나도 없더라도 빈 본문으로 200 을 반환한다.
+	c.Writer.WriteHeaderNow()
+}
+
+// `POST` /dlq/import
+// export 로 내려받은 NDJSON 을 DLQ에 저장하도록 한다.
+// 이미 있는 Key 는 overwrite=true 가 아니라면 건너뛰고, TTL 이 없는 Entry 는 ttl (기본값 72h) 로 저장한다.
+// 잘못된 줄이 있으면 그 앞까지만 저장하고 400 을 반환한다.
+// ex) ?overwrite=true&ttl=24h
+func (h *DLQHandler) ImportDLQ(c *gin.Context) {
+	var opts ImportOptions
+	if v := c.Query("overwrite"); v != "" {
+		overwrite, err := strconv.ParseBool(v)
+		if err != nil {
+			c.Error(err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid overwrite: %v", err)})
+			return
+		}
+		opts.Overwrite = overwrite
+	}
+	if v := c.Query("ttl"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 {
+			err = fmt.Errorf("invalid ttl %q", v)
+			c.Error(err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		opts.TTL = ttl
+	}
+
+	// Body 크기는 Route 의 LimitBody 가 maxImportBody 로 제한한다.
+	result, err := h.Service.ImportDLQ(c.Request.Context(), c.Request.Body, opts)
+	audit.SetTarget(c, fmt.Sprintf("imported=%d,skipped=%d", len(result.Imported), len(result.Skipped)))
+	if err != nil {
+		c.Error(err)
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrInvalidImport) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error(), "imported": result.Imported, "skipped": result.Skipped})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// `GET` /dlq/search
+// DLQ 내용으로 검색하여, 최근에 실패한 순서로 가져오도록 한다.
+// prefix, topic, recipient, traceId, serviceType, error(부분 일치), since, until(RFC3339), limit 으로 필터링할 수 있다.
+// ex) ?recipient=user-1&since=2025-01-01T00:00:00Z
+func (h *DLQHandler) SearchDLQ(c *gin.Context) {
+	q, err := searchQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if q.Limit, err = strconv.Atoi(limit); err != nil || q.Limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit: must be a positive integer"})
+			return
+		}
+	}
+
+	result, err := h.Service.SearchDLQ(c.Request.Context(), q)
+	if err != nil {
+		c.JSON(searchStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// `GET` /dlq/stats
+// 검색 조건에 맞는 DLQ를 prefix, topic, 실패 사유, 실패한 시각(시간 단위) 별로 집계하도록 한다.
+// ex) ?since=2025-01-01T00:00:00Z&until=2025-01-02T00:00:00Z
+func (h *DLQHandler) AggregateDLQ(c *gin.Context) {
+	q, err := searchQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.Service.AggregateDLQ(c.Request.Context(), q)
+	if err != nil {
+		c.JSON(searchStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func searchQuery(c *gin.Context) (SearchQuery, error) {
+	q := SearchQuery{
+		Prefix:      c.Query("prefix"),
+		Topic:       c.Query("topic"),
+		Recipient:   c.Query("recipient"),
+		TraceId:     c.Query("traceId"),
+		ServiceType: c.Query("serviceType"),
+		Error:       c.Query("error"),
+	}
+	var err error
+	if q.Since, err = parseTime(c.Query("since")); err != nil {
+		return SearchQuery{}, fmt.Errorf("Invalid since: %v", err)
+	}
+	if q.Until, err = parseTime(c.Query("until")); err != nil {
+		return SearchQuery{}, fmt.Errorf("Invalid until: %v", err)
+	}
+	return q, nil
+}
+
+func searchStatus(err error) int {
+	if errors.Is(err, ErrOutsideDLQ) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -1,28 +1,91 @@
 package dlq
 
 import (
+	"fmt"
 	"strings"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	"github.com/segmentio/kafka-go"
 
 	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"github.com/HoBom-s/hobom-event-processor/pkg/utils"
 )
 
 // DLQ Key를 통해, Kafka Topic을 추출하도록 한다.
 // 올바른 Key가 맵핑되지 않을 경우, `unknown-topic`을 반환하도록 한다.
 func inferTopicFromKey(key string) string {
 	switch {
-	case strings.HasPrefix(key, poller.HoBomTodayMenuDLQPrefix):
+	case strings.HasPrefix(key, poller.HoBomTodayMenuDLQPrefix), strings.HasPrefix(key, poller.HoBomInvalidMessageDLQPrefix):
 		return poller.HoBomMessage
-	case strings.HasPrefix(key, poller.HoBomLogDLQPrefix):
+	case strings.HasPrefix(key, poller.HoBomLogDLQPrefix), strings.HasPrefix(key, poller.HoBomInvalidLogDLQPrefix):
 		return poller.HoBomLog
 	default:
 		return "unknown-topic"
 	}
 }
 
+// DLQ Key를 통해, Outbox Event Type을 추출하도록 한다.
+// 올바른 Key가 맵핑되지 않을 경우, 빈 문자열을 반환하도록 한다.
+func inferEventTypeFromKey(key string) string {
+	switch {
+	case strings.HasPrefix(key, poller.HoBomTodayMenuDLQPrefix), strings.HasPrefix(key, poller.HoBomInvalidMessageDLQPrefix):
+		return poller.EventTypeHoBomMessage
+	case strings.HasPrefix(key, poller.HoBomLogDLQPrefix), strings.HasPrefix(key, poller.HoBomInvalidLogDLQPrefix):
+		return poller.EventTypeHoBomLog
+	default:
+		return ""
+	}
+}
+
 // 전달받은 Parameter에서 `:` 기준으로 문자열을 자른 후, `EventID`를 추출하도록 한다.
 // Redis에 저장되는 DLQ Key의 경우 `dlq:[category]:event-id`와 같은 규칙을 따르고 있으므로,
 // `:` 로 분류된 맨 마지막 문자열이 `EventID` 이다.
 func extractEventIdFromKey(key string) string {
 	parts := strings.Split(key, ":")
 	return parts[len(parts)-1]
-}
\ No newline at end of file
+}
+
+// 재발행할 DLQ Key 를 검증하고, Outbox 를 `SENT` 로 갱신할 Event ID 를 반환한다.
+func replayEventId(key string) (string, error) {
+	if !strings.HasPrefix(key, "dlq:") {
+		return "", fmt.Errorf("%w: %s", ErrOutsideDLQ, key)
+	}
+	eventId := extractEventIdFromKey(key)
+	if utils.IsEmptyString(eventId) {
+		return "", fmt.Errorf("%w format, cannot extract event ID from: %s", ErrInvalidKey, key)
+	}
+	return eventId, nil
+}
+
+// 검증에 실패하여 `dlq:invalid:` 에 저장된 Entry 는, 재발행 전에 Payload 를 다시 검증하도록 한다.
+// 그 외의 Entry 는 발행 시점에 이미 검증되었으므로 그대로 재발행한다.
+func validateReplay(key string, entry poller.DLQEntry) error {
+	if !strings.HasPrefix(key, poller.InvalidDLQPrefix) {
+		return nil
+	}
+	if err := poller.ValidatePayload(inferTopicFromKey(key), entry.Payload); err != nil {
+		return fmt.Errorf("DLQ %s is still invalid: %w", key, err)
+	}
+	return nil
+}
+
+// DLQ Entry 로부터 재발행할 Event 를 만들도록 한다.
+// Attempt Header 를 1 증가시켜, 컨슈머가 재발행된 Event 임을 알 수 있도록 한다.
+// Envelope 도입 이전에 저장된 Entry 는 Key 와 Header 가 없으므로, DLQ Key 로부터 Event ID 와 Topic 을 복원한다.
+func replayEvent(key string, entry poller.DLQEntry) publisher.Event {
+	event := entry.Event()
+	if event.Topic == "" {
+		event.Topic = inferTopicFromKey(key)
+	}
+	if len(event.Headers) == 0 {
+		eventId := extractEventIdFromKey(key)
+		event.Key = eventId
+		event.Headers = []kafka.Header{
+			{Key: publisher.HeaderEventId, Value: []byte(eventId)},
+			{Key: publisher.HeaderEventType, Value: []byte(inferEventTypeFromKey(key))},
+		}
+	}
+	event.Timestamp = time.Now().UTC()
+	return event.WithAttempt(event.Attempt() + 1)
+}
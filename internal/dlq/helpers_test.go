@@ -28,6 +28,26 @@ func TestInferTopicFromKey(t *testing.T) {
 	}
 }
 
+func TestInferEventTypeFromKey(t *testing.T) {
+	tests := []struct {
+		key       string
+		eventType string
+	}{
+		{poller.HoBomTodayMenuDLQPrefix + "event-1", poller.EventTypeHoBomMessage},
+		{poller.HoBomLogDLQPrefix + "event-2", poller.EventTypeHoBomLog},
+		{"dlq:unknown:event-3", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got := inferEventTypeFromKey(tt.key)
+			if got != tt.eventType {
+				t.Errorf("inferEventTypeFromKey(%q) = %q, want %q", tt.key, got, tt.eventType)
+			}
+		})
+	}
+}
+
 func TestExtractEventIdFromKey(t *testing.T) {
 	tests := []struct {
 		key     string
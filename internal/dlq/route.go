@@ -4,19 +4,36 @@ import (
 	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	"github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
 	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
 	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
 )
 
-func RegisterRoutes(router *gin.Engine, redisDLQ *redis.RedisDLQStore, pub publisher.KafkaPublisher, conn *grpc.ClientConn) {
+// RegisterRoutes registers the DLQ routes. Inspecting, searching and
+// exporting entries requires the read permission; editing, replaying,
+// deleting, purging and importing them requires write. Everything but the reads is recorded in the audit log.
+func RegisterRoutes(router *gin.Engine, guard *auth.Guard, auditor *audit.AuditService, redisDLQ *redis.RedisDLQStore, pub publisher.KafkaPublisher, conn *grpc.ClientConn) {
 	service := NewService(redisDLQ, pub, outboxPb.NewPatchOutboxControllerClient(conn))
 	handler := NewHandler(service)
 
 	dlq := router.Group(poller.HoBomEventProcessorInternalApiPrefix + "/dlq")
 	{
-		dlq.GET("", handler.GetDLQS)
-		dlq.GET("/:key", handler.GetDLQ)
-		dlq.POST("/retry/:key", handler.RetryDLQ)
+		read := guard.Require(auth.PermissionRead)
+		write := guard.Require(auth.PermissionWrite)
+
+		dlq.GET("", read, handler.GetDLQS)
+		dlq.GET("/:key", read, handler.GetDLQ)
+		dlq.GET("/:key/history", read, handler.GetDLQHistory)
+		dlq.PUT("/:key", auditor.Track(audit.ActionDLQEdit, audit.Param("key")), auth.LimitBody(maxEditBody), write, handler.EditDLQ)
+		dlq.GET("/search", read, handler.SearchDLQ)
+		dlq.GET("/stats", read, handler.AggregateDLQ)
+		dlq.GET("/export", auditor.Track(audit.ActionDLQExport, nil), read, handler.ExportDLQ)
+		dlq.POST("/import", auditor.Track(audit.ActionDLQImport, nil), auth.LimitBody(maxImportBody), write, handler.ImportDLQ)
+		dlq.POST("/retry", auditor.Track(audit.ActionDLQRetryBatch, nil), write, handler.RetryDLQBatch)
+		dlq.POST("/retry/:key", auditor.Track(audit.ActionDLQRetry, audit.Param("key")), write, handler.RetryDLQ)
+		dlq.DELETE("", auditor.Track(audit.ActionDLQPurge, audit.Query("prefix")), write, handler.PurgeDLQ)
+		dlq.DELETE("/:key", auditor.Track(audit.ActionDLQDelete, audit.Param("key")), write, handler.DeleteDLQ)
 	}
 }
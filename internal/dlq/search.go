@@ -0,0 +1,247 @@
+package dlq
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+)
+
+const (
+	defaultSearchLimit = 100
+	maxSearchLimit     = 1000
+)
+
+// SearchQuery selects DLQ entries by their content. Empty fields match every
+// entry. Recipient, TraceId and ServiceType only match JSON payloads;
+// protobuf and Avro entries are not decoded.
+type SearchQuery struct {
+	// Prefix limits the scan to keys starting with it, dlq: by default.
+	Prefix string
+	Topic  string
+	// Recipient matches messages sent to it.
+	Recipient string
+	// TraceId and ServiceType match log batches holding a log with them.
+	TraceId     string
+	ServiceType string
+	// Error matches entries whose validation reason or publish error
+	// contains it, case-insensitively.
+	Error string
+	// Since and Until bound when the entries failed.
+	Since time.Time
+	Until time.Time
+	// Limit caps the entries returned by SearchDLQ, 100 by default and at
+	// most 1000. It does not apply to AggregateDLQ.
+	Limit int
+}
+
+// SearchHit summarises an entry matched by SearchDLQ.
+type SearchHit struct {
+	Key    string `json:"key"`
+	Topic  string `json:"topic"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+	// FailedAt is when the entry failed, estimated from its TTL for entries
+	// saved before it was recorded.
+	FailedAt time.Time `json:"failedAt,omitzero"`
+}
+
+// SearchResult is a page of SearchDLQ.
+type SearchResult struct {
+	Items []SearchHit `json:"items"`
+	// Matched counts every matching entry, also those beyond the limit.
+	Matched int `json:"matched"`
+	Scanned int `json:"scanned"`
+}
+
+// Bucket counts the entries sharing a key.
+type Bucket struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+// Aggregation counts the entries matched by a SearchQuery.
+type Aggregation struct {
+	Total int `json:"total"`
+	// ByPrefix, ByTopic and ByReason are sorted by count, highest first.
+	ByPrefix []Bucket `json:"byPrefix"`
+	ByTopic  []Bucket `json:"byTopic"`
+	ByReason []Bucket `json:"byReason"`
+	// ByHour is keyed by the start of the hour in RFC 3339 UTC, oldest first.
+	// Entries whose failure time is unknown are counted under "unknown".
+	ByHour []Bucket `json:"byHour"`
+}
+
+// SearchDLQ returns the entries matching q, newest failure first.
+func (s *DLQService) SearchDLQ(ctx context.Context, q SearchQuery) (SearchResult, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	result := SearchResult{Items: []SearchHit{}}
+	var hits []SearchHit
+	scanned, err := s.scan(ctx, q, func(key string, entry poller.DLQEntry, failedAt time.Time) {
+		hits = append(hits, SearchHit{Key: key, Topic: entry.Topic, Reason: entry.Reason, Error: entry.Error, FailedAt: failedAt})
+	})
+	if err != nil {
+		return result, err
+	}
+
+	slices.SortFunc(hits, func(a, b SearchHit) int {
+		return cmp.Or(b.FailedAt.Compare(a.FailedAt), strings.Compare(a.Key, b.Key))
+	})
+	result.Scanned = scanned
+	result.Matched = len(hits)
+	result.Items = append(result.Items, hits[:min(limit, len(hits))]...)
+	return result, nil
+}
+
+// AggregateDLQ counts the entries matching q by key prefix, topic, failure
+// reason and hour of failure.
+func (s *DLQService) AggregateDLQ(ctx context.Context, q SearchQuery) (Aggregation, error) {
+	byPrefix := map[string]int{}
+	byTopic := map[string]int{}
+	byReason := map[string]int{}
+	byHour := map[string]int{}
+	total := 0
+	_, err := s.scan(ctx, q, func(key string, entry poller.DLQEntry, failedAt time.Time) {
+		total++
+		byPrefix[keyPrefix(key)]++
+		byTopic[cmp.Or(entry.Topic, "unknown")]++
+		byReason[cmp.Or(entry.Reason, entry.Error, "unknown")]++
+		hour := "unknown"
+		if !failedAt.IsZero() {
+			hour = failedAt.UTC().Truncate(time.Hour).Format(time.RFC3339)
+		}
+		byHour[hour]++
+	})
+	if err != nil {
+		return Aggregation{}, err
+	}
+
+	hours := buckets(byHour)
+	// RFC 3339 UTC 시각은 문자열 순서가 시간 순서와 같으며, "unknown" 은 마지막에 온다.
+	slices.SortFunc(hours, func(a, b Bucket) int { return strings.Compare(a.Key, b.Key) })
+	return Aggregation{
+		Total:    total,
+		ByPrefix: byCount(byPrefix),
+		ByTopic:  byCount(byTopic),
+		ByReason: byCount(byReason),
+		ByHour:   hours,
+	}, nil
+}
+
+// scan calls fn for every entry matching q and returns the number of
+// entries read. Keys are scanned page by page and the entries of a page read
+// in one round trip; keys removed while scanning are skipped.
+func (s *DLQService) scan(ctx context.Context, q SearchQuery, fn func(key string, entry poller.DLQEntry, failedAt time.Time)) (int, error) {
+	prefix := cmp.Or(q.Prefix, "dlq:")
+	if !strings.HasPrefix(prefix, "dlq:") {
+		return 0, fmt.Errorf("%w: %s", ErrOutsideDLQ, prefix)
+	}
+
+	scanned := 0
+	err := s.redisDLQ.Scan(ctx, prefix+"*", func(keys []string) error {
+		values, err := s.redisDLQ.GetMany(ctx, keys)
+		if err != nil {
+			return fmt.Errorf("failed to get DLQ entries: %w", err)
+		}
+		for _, value := range values {
+			entry, err := poller.DecodeDLQEntry(value.Payload)
+			if err != nil {
+				continue
+			}
+			scanned++
+
+			failedAt := entry.FailedAt
+			if failedAt.IsZero() {
+				failedAt = estimateFailedAt(value.TTL)
+			}
+			if q.matches(entry, failedAt) {
+				fn(value.Key, entry, failedAt)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan DLQ keys: %w", err)
+	}
+	return scanned, nil
+}
+
+// estimateFailedAt derives when an entry saved before FailedAt was recorded
+// failed from its remaining TTL, as every entry is saved with TTL72Hours.
+func estimateFailedAt(ttl time.Duration) time.Time {
+	if ttl <= 0 || ttl > poller.TTL72Hours {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl - poller.TTL72Hours).UTC().Truncate(time.Second)
+}
+
+func (q SearchQuery) matches(entry poller.DLQEntry, failedAt time.Time) bool {
+	if q.Topic != "" && entry.Topic != q.Topic {
+		return false
+	}
+	if q.Error != "" {
+		text := strings.ToLower(entry.Reason + "\n" + entry.Error)
+		if !strings.Contains(text, strings.ToLower(q.Error)) {
+			return false
+		}
+	}
+	if !q.Since.IsZero() && (failedAt.IsZero() || failedAt.Before(q.Since)) {
+		return false
+	}
+	if !q.Until.IsZero() && (failedAt.IsZero() || failedAt.After(q.Until)) {
+		return false
+	}
+	if q.Recipient == "" && q.TraceId == "" && q.ServiceType == "" {
+		return true
+	}
+	if entry.Payload == nil {
+		return false
+	}
+
+	// Message 는 JSON 객체, Log 는 JSON 배열로 저장된다.
+	if q.Recipient != "" {
+		var message poller.DeliverHoBomMessageCommand
+		if json.Unmarshal(entry.Payload, &message) != nil || message.Recipient != q.Recipient {
+			return false
+		}
+		return q.TraceId == "" && q.ServiceType == ""
+	}
+	var logs poller.HoBomLogBatch
+	if json.Unmarshal(entry.Payload, &logs) != nil {
+		return false
+	}
+	return slices.ContainsFunc(logs, func(l poller.HoBomLogMessageCommand) bool {
+		return (q.TraceId == "" || l.TraceId == q.TraceId) && (q.ServiceType == "" || l.ServiceType == q.ServiceType)
+	})
+}
+
+// keyPrefix returns the key without its event ID, e.g. dlq:invalid:menu:.
+func keyPrefix(key string) string {
+	return key[:strings.LastIndex(key, ":")+1]
+}
+
+func buckets(counts map[string]int) []Bucket {
+	out := make([]Bucket, 0, len(counts))
+	for key, count := range counts {
+		out = append(out, Bucket{Key: key, Count: count})
+	}
+	return out
+}
+
+func byCount(counts map[string]int) []Bucket {
+	out := buckets(counts)
+	slices.SortFunc(out, func(a, b Bucket) int {
+		return cmp.Or(b.Count-a.Count, strings.Compare(a.Key, b.Key))
+	})
+	return out
+}
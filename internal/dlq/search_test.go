@@ -0,0 +1,143 @@
+package dlq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newSearchStore() *mockDLQStore {
+	store := newMockDLQStore()
+	store.data["dlq:menu:a"] = []byte(`{"key":"user-1","topic":"hobom.messages","payload":{"recipient":"user-1","title":"a"},"error":"kafka: leader not available","failedAt":"2025-01-01T10:15:00Z"}`)
+	store.data["dlq:menu:b"] = []byte(`{"key":"user-2","topic":"hobom.messages","payload":{"recipient":"user-2","title":"b"},"error":"kafka: leader not available","failedAt":"2025-01-01T11:30:00Z"}`)
+	store.data["dlq:invalid:log:c"] = []byte(`{"key":"svc","topic":"hobom.logs","payload":[{"serviceType":"hobom-auth","traceId":"trace-1"},{"serviceType":"hobom-menu","traceId":"trace-2"}],"reason":"level: must be one of INFO, WARN, ERROR","failedAt":"2025-01-01T10:45:00Z"}`)
+	store.data["dlq:log:d"] = []byte(`{"key":"svc","topic":"hobom.logs","value":"AAAAAAE=","error":"message too large","failedAt":"2025-01-01T12:00:00Z"}`)
+	return store
+}
+
+func TestSearchDLQ_FiltersByContent(t *testing.T) {
+	svc := NewService(newSearchStore(), &mockKafkaPublisher{}, &mockPatchClient{})
+
+	tests := []struct {
+		name  string
+		query SearchQuery
+		keys  []string
+	}{
+		{"all newest first", SearchQuery{}, []string{"dlq:log:d", "dlq:menu:b", "dlq:invalid:log:c", "dlq:menu:a"}},
+		{"prefix", SearchQuery{Prefix: "dlq:menu:"}, []string{"dlq:menu:b", "dlq:menu:a"}},
+		{"recipient", SearchQuery{Recipient: "user-1"}, []string{"dlq:menu:a"}},
+		{"trace id in batch", SearchQuery{TraceId: "trace-2"}, []string{"dlq:invalid:log:c"}},
+		{"trace id and service type of different logs", SearchQuery{TraceId: "trace-2", ServiceType: "hobom-auth"}, nil},
+		{"error ignores case", SearchQuery{Error: "LEADER"}, []string{"dlq:menu:b", "dlq:menu:a"}},
+		{"error matches reason", SearchQuery{Error: "must be one of"}, []string{"dlq:invalid:log:c"}},
+		{"time window", SearchQuery{Since: time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC), Until: time.Date(2025, 1, 1, 11, 30, 0, 0, time.UTC)}, []string{"dlq:menu:b", "dlq:invalid:log:c"}},
+		{"topic", SearchQuery{Topic: "hobom.logs", ServiceType: "hobom-menu"}, []string{"dlq:invalid:log:c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := svc.SearchDLQ(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Scanned == 0 || result.Matched != len(tt.keys) || len(result.Items) != len(tt.keys) {
+				t.Fatalf("expected %v, got %+v", tt.keys, result)
+			}
+			for i, key := range tt.keys {
+				if result.Items[i].Key != key {
+					t.Errorf("item %d: expected %s, got %s", i, key, result.Items[i].Key)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchDLQ_LimitKeepsMatchedCount(t *testing.T) {
+	svc := NewService(newSearchStore(), &mockKafkaPublisher{}, &mockPatchClient{})
+
+	result, err := svc.SearchDLQ(context.Background(), SearchQuery{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Items) != 1 || result.Items[0].Key != "dlq:log:d" || result.Matched != 4 || result.Scanned != 4 {
+		t.Errorf("expected the newest entry of 4, got %+v", result)
+	}
+}
+
+func TestSearchDLQ_EstimatesFailureTimeOfLegacyEntries(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:menu:legacy", []byte(`{"title":"legacy"}`), 70*time.Hour)
+	store.data["dlq:menu:no-ttl"] = []byte(`{"title":"no ttl"}`)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	result, err := svc.SearchDLQ(context.Background(), SearchQuery{Since: time.Now().Add(-3 * time.Hour)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Items) != 1 || result.Items[0].Key != "dlq:menu:legacy" {
+		t.Fatalf("expected only the entry with a TTL, got %+v", result)
+	}
+	if age := time.Since(result.Items[0].FailedAt); age < 2*time.Hour-time.Minute || age > 2*time.Hour+time.Minute {
+		t.Errorf("expected the entry to have failed about 2h ago, got %v", age)
+	}
+}
+
+func TestSearchDLQ_RejectsPrefixOutsideDLQ(t *testing.T) {
+	svc := NewService(newMockDLQStore(), &mockKafkaPublisher{}, &mockPatchClient{})
+
+	if _, err := svc.SearchDLQ(context.Background(), SearchQuery{Prefix: "audit:"}); !errors.Is(err, ErrOutsideDLQ) {
+		t.Errorf("expected ErrOutsideDLQ, got %v", err)
+	}
+}
+
+func TestAggregateDLQ_CountsByPrefixTopicReasonAndHour(t *testing.T) {
+	store := newSearchStore()
+	store.data["dlq:menu:legacy"] = []byte(`{"title":"legacy"}`)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	agg, err := svc.AggregateDLQ(context.Background(), SearchQuery{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if agg.Total != 5 {
+		t.Errorf("expected 5 entries, got %d", agg.Total)
+	}
+	assertBuckets(t, "prefix", agg.ByPrefix, []Bucket{{"dlq:menu:", 3}, {"dlq:invalid:log:", 1}, {"dlq:log:", 1}})
+	assertBuckets(t, "topic", agg.ByTopic, []Bucket{{"hobom.logs", 2}, {"hobom.messages", 2}, {"unknown", 1}})
+	assertBuckets(t, "reason", agg.ByReason, []Bucket{
+		{"kafka: leader not available", 2},
+		{"level: must be one of INFO, WARN, ERROR", 1},
+		{"message too large", 1},
+		{"unknown", 1},
+	})
+	assertBuckets(t, "hour", agg.ByHour, []Bucket{
+		{"2025-01-01T10:00:00Z", 2},
+		{"2025-01-01T11:00:00Z", 1},
+		{"2025-01-01T12:00:00Z", 1},
+		{"unknown", 1},
+	})
+}
+
+func assertBuckets(t *testing.T, name string, got, want []Bucket) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s: expected %v, got %v", name, want, got)
+		return
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s: expected %v, got %v", name, want, got)
+			return
+		}
+	}
+}
+
+func TestSearchDLQ_ReturnsStoreErrors(t *testing.T) {
+	store := newSearchStore()
+	store.err = errors.New("redis: connection refused")
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	if _, err := svc.SearchDLQ(context.Background(), SearchQuery{}); !errors.Is(err, store.err) {
+		t.Errorf("expected the store error, got %v", err)
+	}
+}
@@ -2,28 +2,39 @@ package dlq
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"time"
+	"strings"
 
 	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	"github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
 	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
 	"github.com/HoBom-s/hobom-event-processor/pkg/utils"
 )
 
+// ErrInvalidPurgePrefix is returned by PurgeDLQ for a prefix outside the
+// dlq: namespace.
+var ErrInvalidPurgePrefix = errors.New("purge prefix must start with dlq:")
+
+// ErrInvalidKey is returned by RetryDLQ and RetryDLQBatch for a key holding
+// no event ID.
+var ErrInvalidKey = errors.New("invalid DLQ key")
+
 type DLQService struct {
-	redisDLQ    redis.DLQStore
-	publisher   publisher.KafkaPublisher
-	patchClient outboxPb.PatchOutboxControllerClient
+	redisDLQ  redis.DLQStore
+	publisher publisher.KafkaPublisher
+	outbox    *outbox.Client
 }
 
 // NewService creates a DLQService with the given dependencies.
 func NewService(redisDLQ redis.DLQStore, pub publisher.KafkaPublisher, patchClient outboxPb.PatchOutboxControllerClient) *DLQService {
 	return &DLQService{
-		redisDLQ:    redisDLQ,
-		publisher:   pub,
-		patchClient: patchClient,
+		redisDLQ:  redisDLQ,
+		publisher: pub,
+		outbox:    outbox.NewClient(patchClient, "", 0),
 	}
 }
 
@@ -35,7 +46,12 @@ func (s *DLQService) GetDLQS(ctx context.Context, prefix string) ([]string, erro
 		dlqPrefix = prefix + "*"
 	}
 
-	keys, err := s.redisDLQ.List(ctx, dlqPrefix)
+	// KEYS 는 Redis 를 막으므로, SCAN 으로 나누어 Key 를 모으도록 한다.
+	var keys []string
+	err := s.redisDLQ.Scan(ctx, dlqPrefix, func(page []string) error {
+		keys = append(keys, page...)
+		return nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list DLQ keys: %w", err)
 	}
@@ -48,35 +64,58 @@ func (s *DLQService) GetDLQValue(ctx context.Context, key string) ([]byte, error
 	return s.redisDLQ.Get(ctx, key)
 }
 
-// RetryDLQ republishes the stored event to Kafka, marks the outbox as SENT via
-// gRPC, and removes the key from the DLQ store. Returns an error if any of
-// the first two steps fail; DLQ deletion failure is logged but not returned.
-func (s *DLQService) RetryDLQ(ctx context.Context, key string) error {
+// GetDLQEntry returns the decoded entry stored under the given DLQ key.
+func (s *DLQService) GetDLQEntry(ctx context.Context, key string) (poller.DLQEntry, error) {
 	data, err := s.redisDLQ.Get(ctx, key)
+	if err != nil {
+		return poller.DLQEntry{}, err
+	}
+	return poller.DecodeDLQEntry(data)
+}
+
+// RetryDLQ republishes the stored event to Kafka with its original key and
+// headers, marks the outbox as SENT via gRPC, and removes the key from the
+// DLQ store. Returns an error if any of the first two steps fail; DLQ
+// deletion failure is logged but not returned. Events rejected by validation
+// are validated again and not published while they are still invalid. A key
+// outside dlq: fails with ErrOutsideDLQ, a key without an event ID with
+// ErrInvalidKey and a missing key with redis.ErrDLQNotFound, before anything
+// is published.
+func (s *DLQService) RetryDLQ(ctx context.Context, key string) error {
+	return s.retryDLQ(ctx, key, nil)
+}
+
+// RetryDLQVersion replays the entry stored under key like RetryDLQ, but only
+// while it is still at version. Otherwise it fails with ErrVersionConflict
+// before anything is published.
+func (s *DLQService) RetryDLQVersion(ctx context.Context, key string, version int) error {
+	return s.retryDLQ(ctx, key, &version)
+}
+
+func (s *DLQService) retryDLQ(ctx context.Context, key string, version *int) error {
+	// Key 로부터 EventID 를 추출할 수 없다면, 재발행하기 전에 실패하도록 한다.
+	eventId, err := replayEventId(key)
+	if err != nil {
+		return err
+	}
+	entry, err := s.GetDLQEntry(ctx, key)
 	if err != nil {
 		return fmt.Errorf("failed to get DLQ: %w", err)
 	}
+	if version != nil && entry.Version != *version {
+		return fmt.Errorf("%w: %s is at version %d, not %d", ErrVersionConflict, key, entry.Version, *version)
+	}
+	if err := validateReplay(key, entry); err != nil {
+		return err
+	}
 
-	// Event를 재발행 하도록 한다.
-	if err = s.publisher.Publish(ctx, publisher.Event{
-		Key:       key,
-		Value:     data,
-		Topic:     inferTopicFromKey(key),
-		Timestamp: time.Now().UTC(),
-	}); err != nil {
+	// Event를 원래의 Key 와 Header 로 재발행 하도록 한다.
+	if err = s.publisher.Publish(ctx, replayEvent(key, entry)); err != nil {
 		return fmt.Errorf("failed to publish: %w", err)
 	}
 
-	// Key로부터 EventID를 추출한 후,
 	// gRPC 호출을 통해, Outbox에 발행 상태를 `SENT`로 업데이트 시키도록 한다.
-	// 만약 EventID가 존재하지 않는다면 다음 로직을 수행하지 않도록 한다.
-	eventId := extractEventIdFromKey(key)
-	if utils.IsEmptyString(eventId) {
-		return fmt.Errorf("invalid DLQ key format, cannot extract event ID from: %s", key)
-	}
-	if _, err := s.patchClient.PatchOutboxMarkAsSentUseCase(ctx, &outboxPb.MarkRequest{
-		EventId: eventId,
-	}); err != nil {
+	if _, err := s.outbox.MarkSent(ctx, []string{eventId}); err != nil {
 		slog.Warn("failed to mark as SENT after DLQ retry", "eventId", eventId, "err", err)
 		return err
 	}
@@ -88,3 +127,109 @@ func (s *DLQService) RetryDLQ(ctx context.Context, key string) error {
 
 	return nil
 }
+
+// RetryDLQBatch replays the entries stored under keys and returns the result
+// of each key; a nil entry means it was replayed, marked SENT and removed.
+// With an AtomicPublisher the entries are committed to Kafka in a single
+// transaction, so either all of them are replayed or none. Nothing is
+// published if any key is missing or malformed, or holds an event rejected
+// by validation that is still invalid.
+func (s *DLQService) RetryDLQBatch(ctx context.Context, keys []string) (map[string]error, error) {
+	events := make([]publisher.Event, len(keys))
+	eventIds := make([]string, len(keys))
+	for i, key := range keys {
+		eventId, err := replayEventId(key)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := s.GetDLQEntry(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DLQ %s: %w", key, err)
+		}
+		if err := validateReplay(key, entry); err != nil {
+			return nil, err
+		}
+		eventIds[i] = eventId
+		events[i] = replayEvent(key, entry)
+	}
+
+	// 트랜잭션을 지원하는 Publisher 라면, 모든 Event 를 하나의 트랜잭션으로 재발행 하도록 한다.
+	var publishErrs []error
+	if atomic, ok := s.publisher.(publisher.AtomicPublisher); ok {
+		err := atomic.PublishAtomically(ctx, events)
+		publishErrs = make([]error, len(events))
+		for i := range publishErrs {
+			publishErrs[i] = err
+		}
+	} else {
+		publishErrs = s.publisher.PublishBatch(ctx, events)
+	}
+
+	results := make(map[string]error, len(keys))
+	var published []string
+	for i, key := range keys {
+		if publishErrs[i] != nil {
+			results[key] = fmt.Errorf("failed to publish: %w", publishErrs[i])
+			continue
+		}
+		published = append(published, eventIds[i])
+	}
+
+	// 재발행된 Event 들의 Outbox 상태를 한 번에 `SENT`로 업데이트 시키도록 한다.
+	unmarked := make(map[string]bool)
+	if failed, err := s.outbox.MarkSent(ctx, published); err != nil {
+		slog.Warn("failed to mark as SENT after DLQ retry", "eventIds", failed, "err", err)
+		for _, eventId := range failed {
+			unmarked[eventId] = true
+		}
+	}
+
+	for i, key := range keys {
+		if publishErrs[i] != nil {
+			continue
+		}
+		if unmarked[eventIds[i]] {
+			results[key] = fmt.Errorf("failed to mark %s as SENT", eventIds[i])
+			continue
+		}
+		results[key] = nil
+		if err := s.redisDLQ.Delete(ctx, key); err != nil {
+			slog.Warn("failed to delete DLQ after retry", "key", key, "err", err)
+		}
+	}
+	return results, nil
+}
+
+// DeleteDLQ removes the entry stored under key without replaying it.
+// Returns an error if the key does not exist.
+func (s *DLQService) DeleteDLQ(ctx context.Context, key string) error {
+	if _, err := s.redisDLQ.Get(ctx, key); err != nil {
+		return err
+	}
+	return s.redisDLQ.Delete(ctx, key)
+}
+
+// PurgeDLQ removes every entry whose key starts with prefix without
+// replaying it and returns the removed keys. The prefix must lie in the
+// dlq: namespace so a purge cannot reach other Redis keys.
+func (s *DLQService) PurgeDLQ(ctx context.Context, prefix string) ([]string, error) {
+	if !strings.HasPrefix(prefix, "dlq:") {
+		return nil, ErrInvalidPurgePrefix
+	}
+
+	// SCAN 으로 읽은 Page 단위로 삭제하여, 삭제 중에도 Redis 를 막지 않도록 한다.
+	deleted := []string{}
+	err := s.redisDLQ.Scan(ctx, prefix+"*", func(keys []string) error {
+		for _, key := range keys {
+			if err := s.redisDLQ.Delete(ctx, key); err != nil {
+				return fmt.Errorf("failed to delete %s: %w", key, err)
+			}
+			deleted = append(deleted, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return deleted, err
+	}
+	return deleted, nil
+}
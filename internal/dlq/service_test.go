@@ -3,12 +3,15 @@ package dlq
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	"github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -16,19 +19,25 @@ import (
 // --- Test doubles ---
 
 type mockDLQStore struct {
+	// mu serializes Update, which may be called concurrently.
+	mu   sync.Mutex
 	data map[string][]byte
+	ttls map[string]time.Duration
 	err  error
+	// listed counts the List calls, which issue KEYS on Redis.
+	listed int
 }
 
 func newMockDLQStore() *mockDLQStore {
-	return &mockDLQStore{data: make(map[string][]byte)}
+	return &mockDLQStore{data: make(map[string][]byte), ttls: make(map[string]time.Duration)}
 }
 
-func (m *mockDLQStore) Save(_ context.Context, key string, payload []byte, _ time.Duration) error {
+func (m *mockDLQStore) Save(_ context.Context, key string, payload []byte, ttl time.Duration) error {
 	if m.err != nil {
 		return m.err
 	}
 	m.data[key] = payload
+	m.ttls[key] = ttl
 	return nil
 }
 
@@ -38,7 +47,7 @@ func (m *mockDLQStore) Get(_ context.Context, key string) ([]byte, error) {
 	}
 	v, ok := m.data[key]
 	if !ok {
-		return nil, errors.New("key not found")
+		return nil, redis.ErrDLQNotFound
 	}
 	return v, nil
 }
@@ -50,6 +59,11 @@ func (m *mockDLQStore) Delete(_ context.Context, key string) error {
 
 // List returns keys matching the "prefix*" glob pattern used by DLQService.
 func (m *mockDLQStore) List(_ context.Context, pattern string) ([]string, error) {
+	m.listed++
+	return m.match(pattern)
+}
+
+func (m *mockDLQStore) match(pattern string) ([]string, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
@@ -63,6 +77,63 @@ func (m *mockDLQStore) List(_ context.Context, pattern string) ([]string, error)
 	return keys, nil
 }
 
+// Scan passes the matching keys in pages of two, to exercise paging.
+func (m *mockDLQStore) Scan(_ context.Context, pattern string, fn func([]string) error) error {
+	keys, err := m.match(pattern)
+	if err != nil {
+		return err
+	}
+	for page := range slices.Chunk(keys, 2) {
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockDLQStore) GetMany(_ context.Context, keys []string) ([]redis.DLQValue, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var values []redis.DLQValue
+	for _, key := range keys {
+		if v, ok := m.data[key]; ok {
+			values = append(values, redis.DLQValue{Key: key, Payload: v, TTL: m.ttls[key]})
+		}
+	}
+	return values, nil
+}
+
+func (m *mockDLQStore) TTL(_ context.Context, key string) (time.Duration, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	if _, ok := m.data[key]; !ok {
+		return 0, redis.ErrDLQNotFound
+	}
+	return m.ttls[key], nil
+}
+
+// Update runs fn under a lock, as the WATCH transaction of RedisDLQStore
+// rejects concurrent updates.
+func (m *mockDLQStore) Update(_ context.Context, key string, fn func([]byte) ([]byte, error)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	v, ok := m.data[key]
+	if !ok {
+		return redis.ErrDLQNotFound
+	}
+	updated, err := fn(v)
+	if err != nil {
+		return err
+	}
+	m.data[key] = updated
+	return nil
+}
+
 type mockKafkaPublisher struct {
 	publishErr error
 	published  []publisher.Event
@@ -76,8 +147,31 @@ func (m *mockKafkaPublisher) Publish(_ context.Context, event publisher.Event) e
 	return nil
 }
 
+func (m *mockKafkaPublisher) PublishBatch(ctx context.Context, events []publisher.Event) []error {
+	errs := make([]error, len(events))
+	for i, event := range events {
+		errs[i] = m.Publish(ctx, event)
+	}
+	return errs
+}
+
 func (m *mockKafkaPublisher) Close() error { return nil }
 
+// mockAtomicPublisher publishes every event of a transaction or none.
+type mockAtomicPublisher struct {
+	mockKafkaPublisher
+	transactions int
+}
+
+func (m *mockAtomicPublisher) PublishAtomically(_ context.Context, events []publisher.Event) error {
+	m.transactions++
+	if m.publishErr != nil {
+		return m.publishErr
+	}
+	m.published = append(m.published, events...)
+	return nil
+}
+
 type mockPatchClient struct {
 	sentErr    error
 	sentCalled bool
@@ -92,6 +186,23 @@ func (m *mockPatchClient) PatchOutboxMarkAsFailedUseCase(_ context.Context, _ *o
 	return &emptypb.Empty{}, nil
 }
 
+func (m *mockPatchClient) PatchOutboxMarkAsSentBatchUseCase(_ context.Context, _ *outboxPb.MarkBatchRequest, _ ...grpc.CallOption) (*outboxPb.MarkBatchResponse, error) {
+	m.sentCalled = true
+	return &outboxPb.MarkBatchResponse{}, m.sentErr
+}
+
+func (m *mockPatchClient) PatchOutboxMarkAsFailedBatchUseCase(_ context.Context, _ *outboxPb.MarkFailedBatchRequest, _ ...grpc.CallOption) (*outboxPb.MarkBatchResponse, error) {
+	return &outboxPb.MarkBatchResponse{}, nil
+}
+
+func (m *mockPatchClient) PatchOutboxClaimUseCase(_ context.Context, _ *outboxPb.ClaimRequest, _ ...grpc.CallOption) (*outboxPb.ClaimResponse, error) {
+	return &outboxPb.ClaimResponse{}, nil
+}
+
+func (m *mockPatchClient) PatchOutboxReleaseExpiredClaimsUseCase(_ context.Context, _ *outboxPb.ReleaseExpiredClaimsRequest, _ ...grpc.CallOption) (*outboxPb.ReleaseExpiredClaimsResponse, error) {
+	return &outboxPb.ReleaseExpiredClaimsResponse{}, nil
+}
+
 // --- GetDLQS ---
 
 func TestGetDLQS_NoPrefix_ReturnsAllKeys(t *testing.T) {
@@ -126,6 +237,26 @@ func TestGetDLQS_WithPrefix_FiltersCorrectly(t *testing.T) {
 	}
 }
 
+func TestGetDLQS_ScansInsteadOfListing(t *testing.T) {
+	store := newMockDLQStore()
+	for _, key := range []string{"dlq:menu:event-1", "dlq:menu:event-2", "dlq:log:event-3"} {
+		store.data[key] = []byte(`{}`)
+	}
+
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+	keys, err := svc.GetDLQS(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(keys) != 3 {
+		t.Errorf("expected the keys of every page, got %v", keys)
+	}
+	if store.listed != 0 {
+		t.Errorf("expected no KEYS call, got %d", store.listed)
+	}
+}
+
 func TestGetDLQS_StoreError(t *testing.T) {
 	store := newMockDLQStore()
 	store.err = errors.New("redis down")
@@ -212,8 +343,8 @@ func TestRetryDLQ_KeyNotFound(t *testing.T) {
 	svc := NewService(newMockDLQStore(), &mockKafkaPublisher{}, &mockPatchClient{})
 	err := svc.RetryDLQ(context.Background(), "dlq:menu:nonexistent")
 
-	if err == nil {
-		t.Fatal("expected error for missing DLQ key, got nil")
+	if !errors.Is(err, redis.ErrDLQNotFound) {
+		t.Fatalf("expected ErrDLQNotFound for missing DLQ key, got %v", err)
 	}
 }
 
@@ -222,11 +353,15 @@ func TestRetryDLQ_EmptyEventId_ReturnsError(t *testing.T) {
 	// Trailing colon produces an empty event ID after parsing.
 	store.data["dlq:menu:"] = []byte(`{}`)
 
-	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+	pub := &mockKafkaPublisher{}
+	svc := NewService(store, pub, &mockPatchClient{})
 	err := svc.RetryDLQ(context.Background(), "dlq:menu:")
 
-	if err == nil {
-		t.Fatal("expected error for empty event ID, got nil")
+	if !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey for empty event ID, got %v", err)
+	}
+	if len(pub.published) != 0 {
+		t.Errorf("expected nothing published for an invalid key, got %d events", len(pub.published))
 	}
 }
 
@@ -245,3 +380,208 @@ func TestRetryDLQ_CorrectTopicForLogKey(t *testing.T) {
 		t.Errorf("expected topic hobom.logs, got %s", pub.published[0].Topic)
 	}
 }
+
+func TestRetryDLQ_ReplaysOriginalKeyAndHeaders(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:log:event-xyz"] = []byte(`{"key":"trace-1","topic":"hobom.logs",` +
+		`"headers":[{"key":"hobom-event-id","value":"event-xyz"},{"key":"hobom-attempt","value":"3"}],` +
+		`"payload":[{"level":"INFO"}]}`)
+	pub := &mockKafkaPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	if err := svc.RetryDLQ(context.Background(), "dlq:log:event-xyz"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := pub.published[0]
+	if got.Key != "trace-1" {
+		t.Errorf("expected original key trace-1, got %q", got.Key)
+	}
+	if string(got.Value) != `[{"level":"INFO"}]` {
+		t.Errorf("expected original payload, got %s", got.Value)
+	}
+	if publisher.HeaderValue(got.Headers, publisher.HeaderEventId) != "event-xyz" {
+		t.Errorf("expected event ID header preserved, got %v", got.Headers)
+	}
+	if got.Attempt() != 4 {
+		t.Errorf("expected attempt 4 on replay, got %d", got.Attempt())
+	}
+}
+
+func TestRetryDLQ_LegacyEntryUsesEventIdAsKey(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:menu::event-abc"] = []byte(`{"type":"MAIL_MESSAGE"}`)
+	pub := &mockKafkaPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	if err := svc.RetryDLQ(context.Background(), "dlq:menu::event-abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := pub.published[0]
+	if got.Key != "event-abc" {
+		t.Errorf("expected event ID as key, got %q", got.Key)
+	}
+	if got.Topic != "hobom.messages" {
+		t.Errorf("expected topic hobom.messages, got %s", got.Topic)
+	}
+}
+
+// --- RetryDLQBatch ---
+
+func TestRetryDLQBatch_Success(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:log:event-1"] = []byte(`[{"level":"INFO"}]`)
+	store.data["dlq:log:event-2"] = []byte(`[{"level":"WARN"}]`)
+	pub := &mockAtomicPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	results, err := svc.RetryDLQBatch(context.Background(), []string{"dlq:log:event-1", "dlq:log:event-2"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pub.transactions != 1 || len(pub.published) != 2 {
+		t.Errorf("expected one transaction with 2 events, got %d with %d", pub.transactions, len(pub.published))
+	}
+	for key, err := range results {
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", key, err)
+		}
+	}
+	if len(store.data) != 0 {
+		t.Errorf("expected replayed entries deleted, got %v", store.data)
+	}
+}
+
+func TestRetryDLQBatch_AbortedTransactionKeepsAllEntries(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:log:event-1"] = []byte(`[{"level":"INFO"}]`)
+	store.data["dlq:log:event-2"] = []byte(`[{"level":"WARN"}]`)
+	pub := &mockAtomicPublisher{mockKafkaPublisher: mockKafkaPublisher{publishErr: errors.New("txn aborted")}}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	results, err := svc.RetryDLQBatch(context.Background(), []string{"dlq:log:event-1", "dlq:log:event-2"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if results["dlq:log:event-1"] == nil || results["dlq:log:event-2"] == nil {
+		t.Errorf("expected every key to fail, got %v", results)
+	}
+	if len(store.data) != 2 {
+		t.Errorf("expected DLQ entries preserved, got %v", store.data)
+	}
+}
+
+func TestRetryDLQBatch_MissingKeyPublishesNothing(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:log:event-1"] = []byte(`[{"level":"INFO"}]`)
+	pub := &mockKafkaPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	_, err := svc.RetryDLQBatch(context.Background(), []string{"dlq:log:event-1", "dlq:log:missing"})
+
+	if err == nil {
+		t.Fatal("expected error for missing key")
+	}
+	if len(pub.published) != 0 {
+		t.Errorf("expected nothing published, got %d events", len(pub.published))
+	}
+}
+
+func TestRetryDLQ_InvalidEntryIsValidatedAgain(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:invalid:menu:event-abc"] = []byte(`{"key":"event-abc","topic":"hobom.messages","payload":{"type":"MAIL_MESSAGE","title":"hi"},"reason":"invalid MESSAGE payload: recipient must not be empty"}`)
+	pub := &mockKafkaPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	if err := svc.RetryDLQ(context.Background(), "dlq:invalid:menu:event-abc"); err == nil {
+		t.Fatal("expected error while the entry is still invalid")
+	}
+	if len(pub.published) != 0 {
+		t.Errorf("expected nothing published, got %d events", len(pub.published))
+	}
+
+	// Payload 를 수정한 후에는 재발행 되어야 한다.
+	store.data["dlq:invalid:menu:event-abc"] = []byte(`{"key":"event-abc","topic":"hobom.messages","payload":{"type":"MAIL_MESSAGE","title":"hi","recipient":"user-1"}}`)
+	if err := svc.RetryDLQ(context.Background(), "dlq:invalid:menu:event-abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pub.published) != 1 || pub.published[0].Topic != "hobom.messages" {
+		t.Errorf("expected the fixed event published to hobom.messages, got %+v", pub.published)
+	}
+}
+
+func TestRetryDLQBatch_StillInvalidEntryPublishesNothing(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:log:event-1"] = []byte(`[{"level":"INFO"}]`)
+	store.data["dlq:invalid:log:event-2"] = []byte(`{"key":"","topic":"hobom.logs","payload":null,"reason":"invalid HOBOM_LOG payload: payload must not be null"}`)
+	pub := &mockKafkaPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	_, err := svc.RetryDLQBatch(context.Background(), []string{"dlq:log:event-1", "dlq:invalid:log:event-2"})
+
+	if err == nil {
+		t.Fatal("expected error for the invalid entry")
+	}
+	if len(pub.published) != 0 {
+		t.Errorf("expected nothing published, got %d events", len(pub.published))
+	}
+}
+
+// --- DeleteDLQ / PurgeDLQ ---
+
+func TestDeleteDLQ_RemovesWithoutReplaying(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:menu:event-1"] = []byte(`{"type":"MAIL_MESSAGE"}`)
+	pub := &mockKafkaPublisher{}
+
+	svc := NewService(store, pub, &mockPatchClient{})
+	if err := svc.DeleteDLQ(context.Background(), "dlq:menu:event-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := store.data["dlq:menu:event-1"]; ok {
+		t.Error("expected the entry removed")
+	}
+	if len(pub.published) != 0 {
+		t.Errorf("expected nothing published, got %d events", len(pub.published))
+	}
+	if err := svc.DeleteDLQ(context.Background(), "dlq:menu:event-1"); err == nil {
+		t.Error("expected an error for a missing key")
+	}
+}
+
+func TestPurgeDLQ_RemovesKeysUnderPrefix(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:invalid:log:event-1"] = []byte(`[]`)
+	store.data["dlq:invalid:log:event-2"] = []byte(`[]`)
+	store.data["dlq:log:event-3"] = []byte(`[]`)
+
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+	deleted, err := svc.PurgeDLQ(context.Background(), "dlq:invalid:log:")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(deleted) != 2 || len(store.data) != 1 {
+		t.Errorf("expected the 2 invalid log entries purged, deleted %v and kept %d", deleted, len(store.data))
+	}
+	if _, ok := store.data["dlq:log:event-3"]; !ok {
+		t.Error("expected entries outside the prefix kept")
+	}
+	if store.listed != 0 {
+		t.Errorf("expected no KEYS call, got %d", store.listed)
+	}
+}
+
+func TestPurgeDLQ_RejectsPrefixOutsideDLQ(t *testing.T) {
+	svc := NewService(newMockDLQStore(), &mockKafkaPublisher{}, &mockPatchClient{})
+
+	for _, prefix := range []string{"", "ack:", "d"} {
+		if _, err := svc.PurgeDLQ(context.Background(), prefix); !errors.Is(err, ErrInvalidPurgePrefix) {
+			t.Errorf("prefix %q: expected ErrInvalidPurgePrefix, got %v", prefix, err)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package dlq
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+)
+
+// ErrOutsideDLQ is returned by ExportDLQ for a prefix or key outside the
+// dlq: namespace.
+var ErrOutsideDLQ = errors.New("keys must start with dlq:")
+
+// ErrInvalidImport is returned by ImportDLQ for a line that is not a valid
+// ExportRecord.
+var ErrInvalidImport = errors.New("invalid DLQ import")
+
+// maxImportLine bounds a line of an import; log batch entries can be large.
+const maxImportLine = 16 << 20
+
+// ExportRecord is a line of an NDJSON DLQ export: an entry as the pollers
+// store it, under its key.
+type ExportRecord struct {
+	Key string `json:"key"`
+	// TTLSeconds is the time the entry had left before it expired when it
+	// was exported, 0 if it never expires.
+	TTLSeconds int64           `json:"ttlSeconds,omitempty"`
+	ExportedAt time.Time       `json:"exportedAt,omitzero"`
+	Entry      poller.DLQEntry `json:"entry"`
+}
+
+// ExportDLQ calls fn with the record of every key, or of every key starting
+// with prefix when keys is empty, in key order, and returns the number of
+// records exported. Keys that expire or are removed while exporting are
+// skipped.
+func (s *DLQService) ExportDLQ(ctx context.Context, prefix string, keys []string, fn func(ExportRecord) error) (int, error) {
+	for _, k := range append([]string{prefix}, keys...) {
+		if k != "" && !strings.HasPrefix(k, "dlq:") {
+			return 0, fmt.Errorf("%w: %s", ErrOutsideDLQ, k)
+		}
+	}
+	if len(keys) == 0 {
+		var err error
+		if keys, err = s.GetDLQS(ctx, prefix); err != nil {
+			return 0, err
+		}
+	}
+	keys = slices.Sorted(slices.Values(keys))
+
+	exported := 0
+	for _, key := range keys {
+		ttl, err := s.redisDLQ.TTL(ctx, key)
+		if errors.Is(err, redis.ErrDLQNotFound) {
+			continue
+		}
+		if err != nil {
+			return exported, fmt.Errorf("failed to get TTL of %s: %w", key, err)
+		}
+		data, err := s.redisDLQ.Get(ctx, key)
+		if err != nil {
+			// TTL 조회와 Get 사이에 재발행되거나 만료된 Entry 는 건너뛴다.
+			continue
+		}
+		entry, err := poller.DecodeDLQEntry(data)
+		if err != nil {
+			return exported, fmt.Errorf("failed to decode %s: %w", key, err)
+		}
+		record := ExportRecord{
+			Key:        key,
+			TTLSeconds: int64(ttl.Round(time.Second) / time.Second),
+			ExportedAt: time.Now().UTC(),
+			Entry:      entry,
+		}
+		if err := fn(record); err != nil {
+			return exported, err
+		}
+		exported++
+	}
+	return exported, nil
+}
+
+// ImportOptions controls how ImportDLQ stores records.
+type ImportOptions struct {
+	// Overwrite replaces entries that already exist instead of skipping them.
+	Overwrite bool
+	// TTL is the expiry of records exported without one. Records keep the
+	// TTL they had left when exported otherwise.
+	TTL time.Duration
+}
+
+// ImportResult lists the keys stored and skipped by ImportDLQ.
+type ImportResult struct {
+	Imported []string `json:"imported"`
+	Skipped  []string `json:"skipped"`
+}
+
+// ImportDLQ stores the ExportRecords read from r as NDJSON, stopping at the
+// first line that is not a valid record. Keys must lie in the dlq:
+// namespace and entries must carry a payload or value to be replayable.
+func (s *DLQService) ImportDLQ(ctx context.Context, r io.Reader, opts ImportOptions) (ImportResult, error) {
+	if opts.TTL <= 0 {
+		opts.TTL = poller.TTL72Hours
+	}
+	result := ImportResult{Imported: []string{}, Skipped: []string{}}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxImportLine)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var record ExportRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return result, fmt.Errorf("%w: line %d: %v", ErrInvalidImport, line, err)
+		}
+		if err := validateImport(record); err != nil {
+			return result, fmt.Errorf("%w: line %d: %v", ErrInvalidImport, line, err)
+		}
+
+		if !opts.Overwrite {
+			_, err := s.redisDLQ.TTL(ctx, record.Key)
+			if err == nil {
+				result.Skipped = append(result.Skipped, record.Key)
+				continue
+			}
+			if !errors.Is(err, redis.ErrDLQNotFound) {
+				return result, fmt.Errorf("failed to check %s: %w", record.Key, err)
+			}
+		}
+
+		value, err := json.Marshal(record.Entry)
+		if err != nil {
+			return result, fmt.Errorf("failed to encode %s: %w", record.Key, err)
+		}
+		ttl := opts.TTL
+		if record.TTLSeconds > 0 {
+			ttl = time.Duration(record.TTLSeconds) * time.Second
+		}
+		if err := s.redisDLQ.Save(ctx, record.Key, value, ttl); err != nil {
+			return result, fmt.Errorf("failed to save %s: %w", record.Key, err)
+		}
+		result.Imported = append(result.Imported, record.Key)
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	return result, nil
+}
+
+func validateImport(record ExportRecord) error {
+	if !strings.HasPrefix(record.Key, "dlq:") {
+		return fmt.Errorf("%w: %s", ErrOutsideDLQ, record.Key)
+	}
+	if len(record.Entry.Payload) == 0 && len(record.Entry.Value) == 0 {
+		return fmt.Errorf("entry of %s has neither payload nor value", record.Key)
+	}
+	if record.TTLSeconds < 0 {
+		return fmt.Errorf("negative ttlSeconds for %s", record.Key)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package dlq
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+)
+
+func TestExportDLQ_StreamsEntriesWithTTL(t *testing.T) {
+	store := newMockDLQStore()
+	store.Save(context.Background(), "dlq:menu:b", []byte(`{"key":"k","topic":"hobom.messages","payload":{"title":"b"}}`), time.Hour)
+	store.Save(context.Background(), "dlq:menu:a", []byte(`{"title":"legacy"}`), 90*time.Minute)
+	store.data["dlq:log:c"] = []byte(`[{"level":"INFO"}]`)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	var records []ExportRecord
+	n, err := svc.ExportDLQ(context.Background(), "dlq:menu:", nil, func(r ExportRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2 || len(records) != 2 || records[0].Key != "dlq:menu:a" || records[1].Key != "dlq:menu:b" {
+		t.Fatalf("expected both menu entries in key order, got %d %+v", n, records)
+	}
+	if records[0].TTLSeconds != 5400 || string(records[0].Entry.Payload) != `{"title":"legacy"}` {
+		t.Errorf("expected the legacy payload with its TTL, got %+v", records[0])
+	}
+	if records[1].Entry.Topic != poller.HoBomMessage || records[1].ExportedAt.IsZero() {
+		t.Errorf("expected the entry metadata, got %+v", records[1])
+	}
+}
+
+func TestExportDLQ_SkipsMissingKeysAndRejectsOtherNamespaces(t *testing.T) {
+	store := newMockDLQStore()
+	store.data["dlq:log:c"] = []byte(`[{"level":"INFO"}]`)
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	n, err := svc.ExportDLQ(context.Background(), "", []string{"dlq:log:gone", "dlq:log:c"}, func(ExportRecord) error { return nil })
+	if err != nil || n != 1 {
+		t.Errorf("expected only the existing key, got %d %v", n, err)
+	}
+
+	_, err = svc.ExportDLQ(context.Background(), "", []string{"audit:events"}, func(ExportRecord) error { return nil })
+	if !errors.Is(err, ErrOutsideDLQ) {
+		t.Errorf("expected ErrOutsideDLQ, got %v", err)
+	}
+}
+
+func TestImportDLQ_RoundTripKeepsTTL(t *testing.T) {
+	source := newMockDLQStore()
+	source.Save(context.Background(), "dlq:menu:a", []byte(`{"key":"k","topic":"hobom.messages","payload":{"title":"a"}}`), 2*time.Hour)
+	source.data["dlq:log:b"] = []byte(`{"key":"svc","topic":"hobom.logs","value":"AAAAAAE="}`)
+	source.Save(context.Background(), "dlq:menu:c", []byte(`{"key":"c","topic":"hobom.messages","payload":{"title":"c"}}`), time.Hour)
+
+	var export bytes.Buffer
+	enc := json.NewEncoder(&export)
+	NewService(source, &mockKafkaPublisher{}, &mockPatchClient{}).ExportDLQ(context.Background(), "", nil, func(r ExportRecord) error {
+		return enc.Encode(r)
+	})
+
+	target := newMockDLQStore()
+	target.Save(context.Background(), "dlq:menu:c", []byte(`{"key":"c","topic":"hobom.messages","payload":{"title":"kept"}}`), time.Minute)
+	svc := NewService(target, &mockKafkaPublisher{}, &mockPatchClient{})
+
+	result, err := svc.ImportDLQ(context.Background(), &export, ImportOptions{TTL: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(result.Imported, ",") != "dlq:log:b,dlq:menu:a" || strings.Join(result.Skipped, ",") != "dlq:menu:c" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if target.ttls["dlq:menu:a"] != 2*time.Hour || target.ttls["dlq:log:b"] != 24*time.Hour {
+		t.Errorf("expected the exported TTL, or the default without one, got %v", target.ttls)
+	}
+	entry, _ := poller.DecodeDLQEntry(target.data["dlq:log:b"])
+	if !bytes.Equal(entry.Value, []byte{0, 0, 0, 0, 1}) {
+		t.Errorf("expected the value to be kept, got %v", entry.Value)
+	}
+	if !strings.Contains(string(target.data["dlq:menu:c"]), "kept") {
+		t.Errorf("expected the existing entry to be kept without overwrite")
+	}
+}
+
+func TestImportDLQ_StopsAtInvalidLine(t *testing.T) {
+	store := newMockDLQStore()
+	svc := NewService(store, &mockKafkaPublisher{}, &mockPatchClient{})
+	input := strings.Join([]string{
+		`{"key":"dlq:menu:a","entry":{"topic":"hobom.messages","payload":{"title":"a"}}}`,
+		``,
+		`{"key":"outbox:1","entry":{"payload":{}}}`,
+		`{"key":"dlq:menu:b","entry":{"payload":{}}}`,
+	}, "\n")
+
+	result, err := svc.ImportDLQ(context.Background(), strings.NewReader(input), ImportOptions{Overwrite: true})
+	if !errors.Is(err, ErrInvalidImport) || !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected ErrInvalidImport at line 3, got %v", err)
+	}
+	if len(result.Imported) != 1 || store.ttls["dlq:menu:a"] != poller.TTL72Hours {
+		t.Errorf("expected the first line stored with the default TTL, got %+v %v", result, store.ttls)
+	}
+
+	_, err = svc.ImportDLQ(context.Background(), strings.NewReader(`{"key":"dlq:menu:c","entry":{"topic":"t"}}`), ImportOptions{})
+	if !errors.Is(err, ErrInvalidImport) {
+		t.Errorf("expected an entry without payload to be rejected, got %v", err)
+	}
+}
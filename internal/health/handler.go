@@ -17,4 +17,11 @@ func NewHandler(service Service) *Handler {
 func (h *Handler) HealthCheck(context *gin.Context) {
 	result := h.service.Check(context.Request.Context())
 	context.JSON(http.StatusOK, result)
-}
\ No newline at end of file
+}
+
+// `GET` /ready
+// Poller 가 반복해서 panic 이 발생하는 등 처리가 불가능한 상태라면 503 을 반환하도록 한다.
+func (h *Handler) ReadinessCheck(context *gin.Context) {
+	result := h.service.Ready(context.Request.Context())
+	context.JSON(result.StatusCode, result)
+}
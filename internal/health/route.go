@@ -1,12 +1,19 @@
 package health
 
 import (
+	"expvar"
+
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterRoutes(router *gin.Engine) {
-	service := NewService()
+// RegisterRoutes registers /health, /ready and /metrics. /ready checks every
+// checker; /metrics serves the expvar variables, including the poller
+// counters.
+func RegisterRoutes(router *gin.Engine, checkers ...Checker) {
+	service := NewService(checkers...)
 	handler := NewHandler(service)
 
 	router.GET("/health", handler.HealthCheck)
-}
\ No newline at end of file
+	router.GET("/ready", handler.ReadinessCheck)
+	router.GET("/metrics", gin.WrapH(expvar.Handler()))
+}
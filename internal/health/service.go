@@ -2,28 +2,56 @@ package health
 
 import (
 	"context"
+	"net/http"
 )
 
 type Service interface {
 	Check(ctx context.Context) HealthStatus
+	Ready(ctx context.Context) HealthStatus
+}
+
+// Checker reports whether a component of the service is ready to work,
+// e.g. the poll loops.
+type Checker interface {
+	Ready(ctx context.Context) error
 }
 
 type HealthStatus struct {
-	Status  		string 	`json:"status"`
-	StatusCode 		int 	`json:"statusCode"`
-	Message 		string 	`json:"message"`
+	Status     string `json:"status"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message"`
 }
 
-type service struct{}
+type service struct {
+	checkers []Checker
+}
 
-func NewService() Service {
-	return &service{}
+func NewService(checkers ...Checker) Service {
+	return &service{checkers: checkers}
 }
 
 func (s *service) Check(ctx context.Context) HealthStatus {
 	return HealthStatus{
-		Status:  		"ok",
-		StatusCode: 	200,
-		Message: 		"Service is healthy",
+		Status:     "ok",
+		StatusCode: http.StatusOK,
+		Message:    "Service is healthy",
 	}
-}
\ No newline at end of file
+}
+
+// Ready reports the service unavailable while any checker is not ready.
+func (s *service) Ready(ctx context.Context) HealthStatus {
+	for _, checker := range s.checkers {
+		if err := checker.Ready(ctx); err != nil {
+			return HealthStatus{
+				Status:     "unavailable",
+				StatusCode: http.StatusServiceUnavailable,
+				Message:    err.Error(),
+			}
+		}
+	}
+	return HealthStatus{
+		Status:     "ok",
+		StatusCode: http.StatusOK,
+		Message:    "Service is ready",
+	}
+}
@@ -0,0 +1,122 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"time"
+
+	pb "github.com/HoBom-s/hobom-event-processor/api/management/v1"
+	"github.com/HoBom-s/hobom-event-processor/internal/health"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Config holds the settings of the gRPC management server.
+type Config struct {
+	// Addr is the address the server listens on.
+	Addr string
+	// ReadinessInterval is how often the serving status reported via
+	// grpc.health.v1 is refreshed from the readiness checkers.
+	ReadinessInterval time.Duration
+	// ShutdownTimeout bounds how long Stop waits for in-flight calls before
+	// closing them.
+	ShutdownTimeout time.Duration
+}
+
+// DefaultConfig returns a Config with:
+//   - Addr: :50052
+//   - ReadinessInterval: 5s
+//   - ShutdownTimeout: 5s
+func DefaultConfig() Config {
+	return Config{
+		Addr:              ":50052",
+		ReadinessInterval: 5 * time.Second,
+		ShutdownTimeout:   5 * time.Second,
+	}
+}
+
+// GRPCServer serves the ManagementService, grpc.health.v1 and server
+// reflection.
+type GRPCServer struct {
+	cfg      Config
+	server   *grpc.Server
+	health   *grpchealth.Server
+	checkers []health.Checker
+}
+
+// NewGRPCServer registers service on a gRPC server whose calls go through
+// the given interceptor. The health of the server and of the
+// ManagementService follows checkers, as GET /ready does.
+func NewGRPCServer(cfg Config, service pb.ManagementServiceServer, interceptor grpc.UnaryServerInterceptor, checkers ...health.Checker) *GRPCServer {
+	server := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	pb.RegisterManagementServiceServer(server, service)
+
+	hs := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, hs)
+	reflection.Register(server)
+
+	return &GRPCServer{cfg: cfg, server: server, health: hs, checkers: checkers}
+}
+
+// Serve refreshes the serving status until ctx is done and serves on lis
+// until Stop is called.
+func (s *GRPCServer) Serve(ctx context.Context, lis net.Listener) error {
+	s.refresh(ctx)
+	go s.watch(ctx)
+
+	err := s.server.Serve(lis)
+	if errors.Is(err, grpc.ErrServerStopped) {
+		return nil
+	}
+	return err
+}
+
+// Stop reports NOT_SERVING so clients stop sending new calls, then waits up
+// to ShutdownTimeout for in-flight calls before closing them.
+func (s *GRPCServer) Stop() {
+	s.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(s.cfg.ShutdownTimeout):
+		slog.Warn("gRPC graceful stop timed out, closing remaining calls", "timeout", s.cfg.ShutdownTimeout)
+		s.server.Stop()
+	}
+}
+
+func (s *GRPCServer) watch(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.ReadinessInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refresh(ctx)
+		}
+	}
+}
+
+// refresh sets the status of the server ("") and of the ManagementService
+// from the readiness checkers.
+func (s *GRPCServer) refresh(ctx context.Context) {
+	status := healthpb.HealthCheckResponse_SERVING
+	for _, c := range s.checkers {
+		if err := c.Ready(ctx); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			break
+		}
+	}
+	// Shutdown 이후에는 SetServingStatus 가 무시되므로 NOT_SERVING 이 유지된다.
+	s.health.SetServingStatus("", status)
+	s.health.SetServingStatus(pb.ManagementService_ServiceDesc.ServiceName, status)
+}
@@ -0,0 +1,148 @@
+package management
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/HoBom-s/hobom-event-processor/api/management/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// method is the permission a ManagementService method requires and, for
+// methods that change state, the action recorded in the audit log.
+type method struct {
+	permission auth.Permission
+	action     string
+	target     func(req any) string
+}
+
+// methods mirrors the guard and audit middlewares of the HTTP routes. A
+// ManagementService method missing here is rejected.
+var methods = map[string]method{
+	pb.ManagementService_ListDLQ_FullMethodName:     {permission: auth.PermissionRead},
+	pb.ManagementService_GetDLQ_FullMethodName:      {permission: auth.PermissionRead},
+	pb.ManagementService_ListPollers_FullMethodName: {permission: auth.PermissionRead},
+	pb.ManagementService_RetryDLQ_FullMethodName: {
+		permission: auth.PermissionWrite,
+		action:     audit.ActionDLQRetry,
+		target:     func(req any) string { return req.(*pb.RetryDLQRequest).GetKey() },
+	},
+	pb.ManagementService_RetryDLQBatch_FullMethodName: {
+		permission: auth.PermissionWrite,
+		action:     audit.ActionDLQRetryBatch,
+		target:     func(req any) string { return strings.Join(req.(*pb.RetryDLQBatchRequest).GetKeys(), ",") },
+	},
+	pb.ManagementService_DeleteDLQ_FullMethodName: {
+		permission: auth.PermissionWrite,
+		action:     audit.ActionDLQDelete,
+		target:     func(req any) string { return req.(*pb.DeleteDLQRequest).GetKey() },
+	},
+	pb.ManagementService_PurgeDLQ_FullMethodName: {
+		permission: auth.PermissionWrite,
+		action:     audit.ActionDLQPurge,
+		target:     func(req any) string { return "prefix=" + req.(*pb.PurgeDLQRequest).GetPrefix() },
+	},
+}
+
+// UnaryInterceptor authenticates ManagementService calls with guard and
+// records the calls that change state with auditor. Calls of other services,
+// i.e. health and reflection, are left unauthenticated.
+func UnaryInterceptor(guard *auth.Guard, auditor *audit.AuditService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, "/"+pb.ManagementService_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+		m, ok := methods[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "method %s is not exposed", info.FullMethod)
+		}
+
+		start := time.Now()
+		principal, res, err := authorize(ctx, guard, m, req, handler)
+		if m.action != "" {
+			record(ctx, auditor, m, req, principal, start, res, err)
+		}
+		return res, err
+	}
+}
+
+// authorize calls handler when the caller is granted the permission of m.
+func authorize(ctx context.Context, guard *auth.Guard, m method, req any, handler grpc.UnaryHandler) (auth.Principal, any, error) {
+	principal, err := guard.AuthenticateMetadata(ctx)
+	if err != nil {
+		// HTTP 와 마찬가지로 거부 사유는 응답에 노출하지 않고 로그로만 남긴다.
+		slog.Warn("rejected internal gRPC call", "err", err)
+		return auth.Principal{}, nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !principal.Role.Allows(m.permission) {
+		return principal, nil, status.Errorf(codes.PermissionDenied, "role %s does not grant %s access", principal.Role, m.permission)
+	}
+	res, err := handler(auth.WithPrincipal(ctx, principal), req)
+	return principal, res, err
+}
+
+// record appends the audit record of a call, with the HTTP status the call
+// would have been answered with so records of both APIs read alike.
+func record(ctx context.Context, auditor *audit.AuditService, m method, req any, principal auth.Principal, start time.Time, res any, err error) {
+	code := httpStatus(status.Code(err))
+	rec := redis.AuditRecord{
+		Time:       start,
+		RequestId:  requestId(ctx),
+		Actor:      principal.Subject,
+		AuthMethod: principal.Method,
+		Action:     m.action,
+		Target:     m.target(req),
+	}
+	if err != nil {
+		rec.Error = status.Convert(err).Message()
+	} else if batch, ok := res.(*pb.RetryDLQBatchResponse); ok && len(batch.GetFailed()) > 0 {
+		code = http.StatusMultiStatus
+	}
+	rec.Status = code
+	rec.Outcome = audit.OutcomeOf(code)
+	if p, ok := peer.FromContext(ctx); ok {
+		rec.RemoteAddr = p.Addr.String()
+	}
+
+	if _, err := auditor.Record(context.WithoutCancel(ctx), rec); err != nil {
+		slog.Error("failed to record audit event", "action", rec.Action, "target", rec.Target, "requestId", rec.RequestId, "err", err)
+	}
+}
+
+// requestId returns the x-request-id metadata of the call, or a new ID.
+func requestId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(strings.ToLower(audit.HeaderRequestId)); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	return audit.NewRequestId()
+}
+
+// httpStatus maps the codes returned by ManagementServer to HTTP statuses.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
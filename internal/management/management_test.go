@@ -0,0 +1,401 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/HoBom-s/hobom-event-processor/api/management/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	"github.com/HoBom-s/hobom-event-processor/internal/dlq"
+	"github.com/HoBom-s/hobom-event-processor/internal/health"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// --- Test doubles ---
+
+type memoryDLQStore struct {
+	mu        sync.Mutex
+	data      map[string][]byte
+	deleteErr error
+}
+
+func (m *memoryDLQStore) Save(_ context.Context, key string, payload []byte, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = payload
+	return nil
+}
+
+func (m *memoryDLQStore) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.data[key]
+	if !ok {
+		return nil, redis.ErrDLQNotFound
+	}
+	return v, nil
+}
+
+func (m *memoryDLQStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memoryDLQStore) List(_ context.Context, pattern string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for k := range m.data {
+		if strings.HasPrefix(k, strings.TrimSuffix(pattern, "*")) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memoryDLQStore) Scan(ctx context.Context, pattern string, fn func([]string) error) error {
+	keys, _ := m.List(ctx, pattern)
+	if len(keys) == 0 {
+		return nil
+	}
+	return fn(keys)
+}
+
+func (m *memoryDLQStore) GetMany(_ context.Context, keys []string) ([]redis.DLQValue, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var values []redis.DLQValue
+	for _, key := range keys {
+		if v, ok := m.data[key]; ok {
+			values = append(values, redis.DLQValue{Key: key, Payload: v})
+		}
+	}
+	return values, nil
+}
+
+func (m *memoryDLQStore) TTL(_ context.Context, key string) (time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.data[key]; !ok {
+		return 0, redis.ErrDLQNotFound
+	}
+	return poller.TTL72Hours, nil
+}
+
+func (m *memoryDLQStore) Update(_ context.Context, key string, fn func([]byte) ([]byte, error)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.data[key]
+	if !ok {
+		return redis.ErrDLQNotFound
+	}
+	updated, err := fn(v)
+	if err != nil {
+		return err
+	}
+	m.data[key] = updated
+	return nil
+}
+
+type memoryAuditLog struct {
+	mu      sync.Mutex
+	records []redis.AuditRecord
+}
+
+func (l *memoryAuditLog) Append(_ context.Context, record redis.AuditRecord) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	record.Id = fmt.Sprint(len(l.records) + 1)
+	l.records = append(l.records, record)
+	return record.Id, nil
+}
+
+func (l *memoryAuditLog) Range(context.Context, redis.AuditRange) ([]redis.AuditRecord, error) {
+	return nil, nil
+}
+
+func (l *memoryAuditLog) last(t *testing.T) redis.AuditRecord {
+	t.Helper()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.records) == 0 {
+		t.Fatal("no audit record")
+	}
+	return l.records[len(l.records)-1]
+}
+
+type stubPollers struct {
+	statuses []poller.LoopStatus
+	mu       sync.Mutex
+	err      error
+}
+
+func (s *stubPollers) Status() []poller.LoopStatus { return s.statuses }
+
+func (s *stubPollers) Ready(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *stubPollers) setReady(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// --- Helpers ---
+
+type testEnv struct {
+	store   *memoryDLQStore
+	log     *memoryAuditLog
+	pollers *stubPollers
+	conn    *grpc.ClientConn
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	env := &testEnv{
+		store:   &memoryDLQStore{data: map[string][]byte{}},
+		log:     &memoryAuditLog{},
+		pollers: &stubPollers{},
+	}
+	guard := auth.NewGuardWith(auth.NewTokenAuthenticator([]auth.Credential{
+		{Subject: "ci", Role: auth.RoleOperator, Secret: "operator-token"},
+		{Subject: "grafana", Role: auth.RoleReader, Secret: "reader-token"},
+	}))
+	server := NewGRPCServer(
+		Config{ReadinessInterval: 10 * time.Millisecond, ShutdownTimeout: time.Second},
+		NewManagementServer(dlq.NewService(env.store, nil, nil), env.pollers),
+		UnaryInterceptor(guard, audit.NewService(env.log)),
+		health.Checker(env.pollers),
+	)
+
+	lis := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	go server.Serve(ctx, lis)
+	t.Cleanup(func() {
+		cancel()
+		server.Stop()
+	})
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	env.conn = conn
+	return env
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+// --- Tests ---
+
+func TestMethods_CoverManagementService(t *testing.T) {
+	for _, m := range pb.ManagementService_ServiceDesc.Methods {
+		name := "/" + pb.ManagementService_ServiceDesc.ServiceName + "/" + m.MethodName
+		spec, ok := methods[name]
+		if !ok {
+			t.Errorf("%s has no permission", name)
+			continue
+		}
+		if spec.permission == auth.PermissionWrite && (spec.action == "" || spec.target == nil) {
+			t.Errorf("%s changes state but is not audited", name)
+		}
+	}
+}
+
+func TestManagement_RejectsMissingCredentials(t *testing.T) {
+	env := newTestEnv(t)
+	client := pb.NewManagementServiceClient(env.conn)
+
+	_, err := client.ListDLQ(context.Background(), &pb.ListDLQRequest{Prefix: "dlq:"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+}
+
+func TestDeleteDLQ_RequiresOperatorAndIsAudited(t *testing.T) {
+	env := newTestEnv(t)
+	env.store.data["dlq:log:event-1"] = []byte(`{}`)
+	client := pb.NewManagementServiceClient(env.conn)
+
+	_, err := client.DeleteDLQ(withToken("reader-token"), &pb.DeleteDLQRequest{Key: "dlq:log:event-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for reader, got %v", err)
+	}
+	denied := env.log.last(t)
+	if denied.Actor != "grafana" || denied.Outcome != audit.OutcomeDenied || denied.Status != 403 {
+		t.Errorf("unexpected denied record: %+v", denied)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(withToken("operator-token"), "x-request-id", "req-1")
+	if _, err := client.DeleteDLQ(ctx, &pb.DeleteDLQRequest{Key: "dlq:log:event-1"}); err != nil {
+		t.Fatalf("DeleteDLQ: %v", err)
+	}
+	if _, ok := env.store.data["dlq:log:event-1"]; ok {
+		t.Error("expected the entry to be deleted")
+	}
+	rec := env.log.last(t)
+	if rec.Actor != "ci" || rec.AuthMethod != "token" || rec.Action != audit.ActionDLQDelete ||
+		rec.Target != "dlq:log:event-1" || rec.Outcome != audit.OutcomeSuccess || rec.RequestId != "req-1" {
+		t.Errorf("unexpected audit record: %+v", rec)
+	}
+
+	_, err = client.DeleteDLQ(withToken("operator-token"), &pb.DeleteDLQRequest{Key: "dlq:log:event-1"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a missing key, got %v", err)
+	}
+	if rec := env.log.last(t); rec.Outcome != audit.OutcomeFailure || rec.Status != 404 {
+		t.Errorf("unexpected failure record: %+v", rec)
+	}
+}
+
+func TestPurgeDLQ_RejectsPrefixOutsideDLQ(t *testing.T) {
+	env := newTestEnv(t)
+	client := pb.NewManagementServiceClient(env.conn)
+
+	_, err := client.PurgeDLQ(withToken("operator-token"), &pb.PurgeDLQRequest{Prefix: "outbox:"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if rec := env.log.last(t); rec.Target != "prefix=outbox:" || rec.Status != 400 {
+		t.Errorf("unexpected audit record: %+v", rec)
+	}
+}
+
+func TestRetryDLQ_MapsErrors(t *testing.T) {
+	env := newTestEnv(t)
+	env.store.data["dlq:menu:"] = []byte(`{}`)
+	env.store.data["dlq:invalid:menu:event-2"] = []byte(`{"key":"event-2","topic":"hobom.messages","payload":{"type":"MAIL_MESSAGE","title":"hi","recipient":""}}`)
+	client := pb.NewManagementServiceClient(env.conn)
+
+	cases := []struct {
+		key    string
+		code   codes.Code
+		status int
+	}{
+		{"dlq:menu:missing", codes.NotFound, 404},
+		{"dlq:menu:", codes.InvalidArgument, 400},
+		{"outbox:event-1", codes.InvalidArgument, 400},
+		{"dlq:invalid:menu:event-2", codes.InvalidArgument, 400},
+	}
+	for _, tc := range cases {
+		_, err := client.RetryDLQ(withToken("operator-token"), &pb.RetryDLQRequest{Key: tc.key})
+		if status.Code(err) != tc.code {
+			t.Errorf("%s: expected %v, got %v", tc.key, tc.code, err)
+		}
+		if rec := env.log.last(t); rec.Target != tc.key || rec.Status != tc.status {
+			t.Errorf("%s: unexpected audit record: %+v", tc.key, rec)
+		}
+	}
+}
+
+func TestGetAndDeleteDLQ_MapErrors(t *testing.T) {
+	env := newTestEnv(t)
+	env.store.data["dlq:menu:event-1"] = []byte(`not json`)
+	client := pb.NewManagementServiceClient(env.conn)
+
+	if _, err := client.GetDLQ(withToken("reader-token"), &pb.GetDLQRequest{Key: "dlq:menu:missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetDLQ of a missing key: expected NotFound, got %v", err)
+	}
+	if _, err := client.GetDLQ(withToken("reader-token"), &pb.GetDLQRequest{Key: "dlq:menu:event-1"}); status.Code(err) != codes.Internal {
+		t.Errorf("GetDLQ of an undecodable entry: expected Internal, got %v", err)
+	}
+
+	if _, err := client.DeleteDLQ(withToken("operator-token"), &pb.DeleteDLQRequest{Key: "dlq:menu:missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteDLQ of a missing key: expected NotFound, got %v", err)
+	}
+	env.store.deleteErr = errors.New("redis down")
+	if _, err := client.DeleteDLQ(withToken("operator-token"), &pb.DeleteDLQRequest{Key: "dlq:menu:event-1"}); status.Code(err) != codes.Internal {
+		t.Errorf("DeleteDLQ failing in Redis: expected Internal, got %v", err)
+	}
+}
+
+func TestListDLQAndPollers_AllowReader(t *testing.T) {
+	env := newTestEnv(t)
+	env.store.data["dlq:log:event-1"] = []byte(`{}`)
+	env.pollers.statuses = []poller.LoopStatus{{
+		Name:      poller.HoBomLog,
+		State:     poller.LoopState("paused"),
+		Cycles:    3,
+		LastRunAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}
+	client := pb.NewManagementServiceClient(env.conn)
+
+	keys, err := client.ListDLQ(withToken("reader-token"), &pb.ListDLQRequest{Prefix: "dlq:"})
+	if err != nil {
+		t.Fatalf("ListDLQ: %v", err)
+	}
+	if len(keys.GetKeys()) != 1 || keys.GetKeys()[0] != "dlq:log:event-1" {
+		t.Errorf("unexpected keys: %v", keys.GetKeys())
+	}
+
+	res, err := client.ListPollers(withToken("reader-token"), &pb.ListPollersRequest{})
+	if err != nil {
+		t.Fatalf("ListPollers: %v", err)
+	}
+	got := res.GetPollers()
+	if len(got) != 1 || got[0].GetName() != poller.HoBomLog || got[0].GetState() != "paused" || got[0].GetCycles() != 3 {
+		t.Fatalf("unexpected pollers: %v", got)
+	}
+	if !got[0].GetLastRunAt().AsTime().Equal(env.pollers.statuses[0].LastRunAt) || got[0].GetLastCycleAt() != nil {
+		t.Errorf("unexpected timestamps: %v", got[0])
+	}
+	if len(env.log.records) != 0 {
+		t.Errorf("expected reads not to be audited, got %v", env.log.records)
+	}
+}
+
+func TestHealth_FollowsReadiness(t *testing.T) {
+	env := newTestEnv(t)
+	client := healthpb.NewHealthClient(env.conn)
+	service := pb.ManagementService_ServiceDesc.ServiceName
+
+	res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING, got %v", res.GetStatus())
+	}
+
+	env.pollers.setReady(errors.New("poll loop panicking"))
+	deadline := time.Now().Add(time.Second)
+	for {
+		res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if err == nil && res.GetStatus() == healthpb.HealthCheckResponse_NOT_SERVING {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected NOT_SERVING once a checker fails, got %v (%v)", res.GetStatus(), err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,158 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/HoBom-s/hobom-event-processor/api/management/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/dlq"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Pollers reports the status of the poll loops, implemented by
+// *poller.Supervisor.
+type Pollers interface {
+	Status() []poller.LoopStatus
+}
+
+// ManagementServer implements the ManagementService on top of the same
+// services as the HTTP routes.
+type ManagementServer struct {
+	pb.UnimplementedManagementServiceServer
+
+	dlq     *dlq.DLQService
+	pollers Pollers
+}
+
+func NewManagementServer(dlqService *dlq.DLQService, pollers Pollers) *ManagementServer {
+	return &ManagementServer{
+		dlq:     dlqService,
+		pollers: pollers,
+	}
+}
+
+func (s *ManagementServer) ListDLQ(ctx context.Context, req *pb.ListDLQRequest) (*pb.ListDLQResponse, error) {
+	keys, err := s.dlq.GetDLQS(ctx, req.GetPrefix())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ListDLQResponse{Keys: keys}, nil
+}
+
+func (s *ManagementServer) GetDLQ(ctx context.Context, req *pb.GetDLQRequest) (*pb.DLQEntry, error) {
+	entry, err := s.dlq.GetDLQEntry(ctx, req.GetKey())
+	if errors.Is(err, redis.ErrDLQNotFound) {
+		return nil, status.Errorf(codes.NotFound, "DLQ not found: %v", err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	headers := make([]*pb.DLQHeader, len(entry.Headers))
+	for i, h := range entry.Headers {
+		headers[i] = &pb.DLQHeader{Key: h.Key, Value: h.Value}
+	}
+	return &pb.DLQEntry{
+		Key:         req.GetKey(),
+		KafkaKey:    entry.Key,
+		Topic:       entry.Topic,
+		Headers:     headers,
+		PayloadJson: string(entry.Payload),
+		Value:       entry.Value,
+		Reason:      entry.Reason,
+		Error:       entry.Error,
+		FailedAt:    timestamp(entry.FailedAt),
+	}, nil
+}
+
+func (s *ManagementServer) RetryDLQ(ctx context.Context, req *pb.RetryDLQRequest) (*pb.RetryDLQResponse, error) {
+	err := s.dlq.RetryDLQ(ctx, req.GetKey())
+	var invalid *poller.ValidationError
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.ErrDLQNotFound):
+		return nil, status.Errorf(codes.NotFound, "DLQ not found: %v", err)
+	case errors.Is(err, dlq.ErrInvalidKey), errors.Is(err, dlq.ErrOutsideDLQ), errors.As(err, &invalid):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.RetryDLQResponse{}, nil
+}
+
+func (s *ManagementServer) RetryDLQBatch(ctx context.Context, req *pb.RetryDLQBatchRequest) (*pb.RetryDLQBatchResponse, error) {
+	if len(req.GetKeys()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "keys must not be empty")
+	}
+	results, err := s.dlq.RetryDLQBatch(ctx, req.GetKeys())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &pb.RetryDLQBatchResponse{Retried: []string{}, Failed: map[string]string{}}
+	for _, key := range req.GetKeys() {
+		if err := results[key]; err != nil {
+			res.Failed[key] = err.Error()
+			continue
+		}
+		res.Retried = append(res.Retried, key)
+	}
+	return res, nil
+}
+
+func (s *ManagementServer) DeleteDLQ(ctx context.Context, req *pb.DeleteDLQRequest) (*pb.DeleteDLQResponse, error) {
+	err := s.dlq.DeleteDLQ(ctx, req.GetKey())
+	if errors.Is(err, redis.ErrDLQNotFound) {
+		return nil, status.Errorf(codes.NotFound, "DLQ not found: %v", err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.DeleteDLQResponse{}, nil
+}
+
+func (s *ManagementServer) PurgeDLQ(ctx context.Context, req *pb.PurgeDLQRequest) (*pb.PurgeDLQResponse, error) {
+	deleted, err := s.dlq.PurgeDLQ(ctx, req.GetPrefix())
+	if errors.Is(err, dlq.ErrInvalidPurgePrefix) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v (deleted %d keys before failing)", err, len(deleted))
+	}
+	return &pb.PurgeDLQResponse{Deleted: deleted}, nil
+}
+
+func (s *ManagementServer) ListPollers(context.Context, *pb.ListPollersRequest) (*pb.ListPollersResponse, error) {
+	statuses := s.pollers.Status()
+	res := &pb.ListPollersResponse{Pollers: make([]*pb.PollerStatus, len(statuses))}
+	for i, st := range statuses {
+		res.Pollers[i] = &pb.PollerStatus{
+			Name:              st.Name,
+			State:             string(st.State),
+			Cycles:            st.Cycles,
+			ItemsProcessed:    st.ItemsProcessed,
+			Panics:            st.Panics,
+			ConsecutivePanics: int32(st.ConsecutivePanics),
+			Restarts:          st.Restarts,
+			LastRunAt:         timestamp(st.LastRunAt),
+			LastCycleAt:       timestamp(st.LastCycleAt),
+			LastError:         st.LastError,
+			LastPanic:         st.LastPanic,
+			LastPanicAt:       timestamp(st.LastPanicAt),
+		}
+	}
+	return res, nil
+}
+
+// timestamp leaves unset times of a LoopStatus unset.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
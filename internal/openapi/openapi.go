@@ -0,0 +1,23 @@
+// Package openapi serves the OpenAPI document of the management API.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Spec is the OpenAPI 3 document of every HTTP route of the service. The
+// client in pkg/client is generated from it, and a test checks it against
+// the registered Gin routes.
+//
+//go:embed openapi.json
+var Spec []byte
+
+// RegisterRoutes registers /openapi.json.
+func RegisterRoutes(router *gin.Engine) {
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", Spec)
+	})
+}
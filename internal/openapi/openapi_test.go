@@ -0,0 +1,79 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	"github.com/HoBom-s/hobom-event-processor/internal/dlq"
+	"github.com/HoBom-s/hobom-event-processor/internal/health"
+	"github.com/HoBom-s/hobom-event-processor/internal/supervisor"
+	"github.com/gin-gonic/gin"
+)
+
+// newRouter registers every route as cmd/main.go does. The dependencies are
+// never called, only the routes are inspected.
+func newRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	guard := auth.NewGuardWith()
+	auditor := audit.NewService(nil)
+
+	health.RegisterRoutes(router)
+	RegisterRoutes(router)
+	audit.RegisterRoutes(router, guard, auditor)
+	supervisor.RegisterRoutes(router, guard, auditor, nil)
+	dlq.RegisterRoutes(router, guard, auditor, redis.NewRedisDLQStore(nil), nil, nil)
+	return router
+}
+
+type document struct {
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+func TestSpec_MatchesRoutes(t *testing.T) {
+	var doc document
+	if err := json.Unmarshal(Spec, &doc); err != nil {
+		t.Fatalf("invalid OpenAPI document: %v", err)
+	}
+	var documented []string
+	for path, operations := range doc.Paths {
+		for method := range operations {
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	var registered []string
+	for _, route := range newRouter().Routes() {
+		registered = append(registered, route.Method+" "+ginParam.ReplaceAllString(route.Path, "{$1}"))
+	}
+
+	for _, route := range registered {
+		if !slices.Contains(documented, route) {
+			t.Errorf("route %s is not documented in openapi.json", route)
+		}
+	}
+	for _, route := range documented {
+		if !slices.Contains(registered, route) {
+			t.Errorf("openapi.json documents %s, which is not registered", route)
+		}
+	}
+}
+
+func TestSpec_Served(t *testing.T) {
+	w := httptest.NewRecorder()
+	newRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/json" || !json.Valid(w.Body.Bytes()) {
+		t.Errorf("expected the JSON document, got %d %s", w.Code, w.Header().Get("Content-Type"))
+	}
+}
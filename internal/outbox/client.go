@@ -0,0 +1,208 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// markBatchSize bounds the number of rows sent in one batched patch call.
+const markBatchSize = 500
+
+// errRejected is reported for rows the backend refused to update in a batch.
+var errRejected = errors.New("rejected by outbox backend")
+
+// Failure is an outbox row to mark FAILED together with the reason.
+type Failure struct {
+	EventId string
+	Reason  string
+}
+
+// ClaimTarget identifies an outbox row to claim at the version it was fetched with.
+type ClaimTarget struct {
+	EventId string
+	Version int32
+}
+
+// Client wraps the outbox patch RPCs of hobom-backend that pollers use to
+// coordinate processing of outbox rows.
+type Client struct {
+	patchClient      outboxPb.PatchOutboxControllerClient
+	owner            string
+	lease            time.Duration
+	claimUnsupported atomic.Bool
+	batchUnsupported atomic.Bool
+}
+
+// NewClient creates a Client that claims rows as owner for the given lease.
+// Callers that never claim rows may pass an empty owner and zero lease.
+func NewClient(patchClient outboxPb.PatchOutboxControllerClient, owner string, lease time.Duration) *Client {
+	return &Client{
+		patchClient: patchClient,
+		owner:       owner,
+		lease:       lease,
+	}
+}
+
+// Claim moves the targets to IN_FLIGHT under this client's owner and lease
+// and returns the set of event IDs it won. Rows claimed by another owner or
+// changed since they were fetched are left out.
+//
+// Backends that do not implement claiming yet answer Unimplemented; every
+// target is then treated as claimed so polling keeps working as before.
+func (c *Client) Claim(ctx context.Context, targets []ClaimTarget) (map[string]bool, error) {
+	claimed := make(map[string]bool, len(targets))
+	if len(targets) == 0 {
+		return claimed, nil
+	}
+
+	if !c.claimUnsupported.Load() {
+		req := &outboxPb.ClaimRequest{
+			Owner:        c.owner,
+			LeaseSeconds: int32(c.lease / time.Second),
+			Targets:      make([]*outboxPb.ClaimTarget, len(targets)),
+		}
+		for i, t := range targets {
+			req.Targets[i] = &outboxPb.ClaimTarget{EventId: t.EventId, Version: t.Version}
+		}
+
+		res, err := c.patchClient.PatchOutboxClaimUseCase(ctx, req)
+		if err == nil {
+			for _, eventId := range res.ClaimedEventIds {
+				claimed[eventId] = true
+			}
+			return claimed, nil
+		}
+		if status.Code(err) != codes.Unimplemented {
+			return nil, err
+		}
+		c.claimUnsupported.Store(true)
+		slog.Warn("outbox backend does not support claims, processing rows unclaimed")
+	}
+
+	for _, t := range targets {
+		claimed[t.EventId] = true
+	}
+	return claimed, nil
+}
+
+// ReleaseExpired returns IN_FLIGHT rows of eventType whose lease has expired
+// to PENDING and reports how many were released.
+func (c *Client) ReleaseExpired(ctx context.Context, eventType string) (int, error) {
+	if c.claimUnsupported.Load() {
+		return 0, nil
+	}
+
+	res, err := c.patchClient.PatchOutboxReleaseExpiredClaimsUseCase(ctx, &outboxPb.ReleaseExpiredClaimsRequest{
+		EventType: eventType,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		c.claimUnsupported.Store(true)
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int(res.Released), nil
+}
+
+// MarkSent marks eventIds SENT and returns the IDs that could not be marked
+// along with the errors that caused it. Rows are sent in batches; backends
+// that do not implement batching are patched one row at a time.
+func (c *Client) MarkSent(ctx context.Context, eventIds []string) ([]string, error) {
+	var (
+		failed []string
+		errs   []error
+	)
+	for start := 0; start < len(eventIds); start += markBatchSize {
+		chunk := eventIds[start:min(start+markBatchSize, len(eventIds))]
+
+		if !c.batchUnsupported.Load() {
+			res, err := c.patchClient.PatchOutboxMarkAsSentBatchUseCase(ctx, &outboxPb.MarkBatchRequest{EventIds: chunk})
+			if !c.fallBackOnUnimplemented(err) {
+				f, e := batchOutcome(chunk, res, err)
+				failed, errs = append(failed, f...), append(errs, e)
+				continue
+			}
+		}
+
+		for _, eventId := range chunk {
+			if _, err := c.patchClient.PatchOutboxMarkAsSentUseCase(ctx, &outboxPb.MarkRequest{EventId: eventId}); err != nil {
+				failed = append(failed, eventId)
+				errs = append(errs, fmt.Errorf("%s: %w", eventId, err))
+			}
+		}
+	}
+	return failed, errors.Join(errs...)
+}
+
+// MarkFailed marks each row FAILED with its reason and returns the IDs that
+// could not be marked along with the errors that caused it. It batches and
+// falls back exactly like MarkSent.
+func (c *Client) MarkFailed(ctx context.Context, failures []Failure) ([]string, error) {
+	var (
+		failed []string
+		errs   []error
+	)
+	for start := 0; start < len(failures); start += markBatchSize {
+		chunk := failures[start:min(start+markBatchSize, len(failures))]
+
+		if !c.batchUnsupported.Load() {
+			req := &outboxPb.MarkFailedBatchRequest{Items: make([]*outboxPb.MarkFailedRequest, len(chunk))}
+			eventIds := make([]string, len(chunk))
+			for i, f := range chunk {
+				req.Items[i] = &outboxPb.MarkFailedRequest{EventId: f.EventId, ErrorMessage: f.Reason}
+				eventIds[i] = f.EventId
+			}
+
+			res, err := c.patchClient.PatchOutboxMarkAsFailedBatchUseCase(ctx, req)
+			if !c.fallBackOnUnimplemented(err) {
+				f, e := batchOutcome(eventIds, res, err)
+				failed, errs = append(failed, f...), append(errs, e)
+				continue
+			}
+		}
+
+		for _, f := range chunk {
+			if _, err := c.patchClient.PatchOutboxMarkAsFailedUseCase(ctx, &outboxPb.MarkFailedRequest{
+				EventId:      f.EventId,
+				ErrorMessage: f.Reason,
+			}); err != nil {
+				failed = append(failed, f.EventId)
+				errs = append(errs, fmt.Errorf("%s: %w", f.EventId, err))
+			}
+		}
+	}
+	return failed, errors.Join(errs...)
+}
+
+// fallBackOnUnimplemented reports whether err means the backend has no batch
+// patch RPCs, remembering it so later calls go straight to per-row patches.
+func (c *Client) fallBackOnUnimplemented(err error) bool {
+	if status.Code(err) != codes.Unimplemented {
+		return false
+	}
+	if !c.batchUnsupported.Swap(true) {
+		slog.Warn("outbox backend does not support batch patches, falling back to per-event calls")
+	}
+	return true
+}
+
+// batchOutcome converts the result of a batch patch call into the IDs that
+// were not updated and the error describing why.
+func batchOutcome(eventIds []string, res *outboxPb.MarkBatchResponse, err error) ([]string, error) {
+	if err != nil {
+		return eventIds, err
+	}
+	if len(res.RejectedEventIds) == 0 {
+		return nil, nil
+	}
+	return res.RejectedEventIds, fmt.Errorf("%d events: %w", len(res.RejectedEventIds), errRejected)
+}
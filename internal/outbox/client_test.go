@@ -0,0 +1,245 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox/outboxtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func newTestClient(t *testing.T, backend *outboxtest.Backend, owner string) *Client {
+	t.Helper()
+	return NewClient(outboxPb.NewPatchOutboxControllerClient(backend.Dial(t)), owner, time.Minute)
+}
+
+func TestClaim_OnlyOneOwnerWins(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{})
+	backend.AddMessage("event-2", &outboxPb.MessagePayload{})
+	a := newTestClient(t, backend, "replica-a")
+	b := newTestClient(t, backend, "replica-b")
+	targets := []ClaimTarget{{EventId: "event-1", Version: 1}, {EventId: "event-2", Version: 1}}
+
+	wonA, err := a.Claim(context.Background(), targets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wonB, err := b.Claim(context.Background(), targets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(wonA) != 2 {
+		t.Errorf("expected first claimer to win both rows, got %v", wonA)
+	}
+	if len(wonB) != 0 {
+		t.Errorf("expected second claimer to win nothing, got %v", wonB)
+	}
+	row, _ := backend.Row("event-1")
+	if row.Status != "IN_FLIGHT" || row.Owner != "replica-a" {
+		t.Errorf("expected row IN_FLIGHT for replica-a, got %s/%s", row.Status, row.Owner)
+	}
+}
+
+func TestClaim_StaleVersionIsRejected(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{})
+	client := newTestClient(t, backend, "replica-a")
+
+	won, err := client.Claim(context.Background(), []ClaimTarget{{EventId: "event-1", Version: 0}})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(won) != 0 {
+		t.Errorf("expected stale version to be rejected, got %v", won)
+	}
+}
+
+func TestReleaseExpired_ReturnsExpiredClaimsToPending(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	backend := outboxtest.NewBackend()
+	backend.SetClock(func() time.Time { return now })
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{})
+	client := newTestClient(t, backend, "replica-a")
+
+	if _, err := client.Claim(context.Background(), []ClaimTarget{{EventId: "event-1", Version: 1}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	released, err := client.ReleaseExpired(context.Background(), "MESSAGE")
+	if err != nil || released != 0 {
+		t.Fatalf("expected nothing released before lease expiry, got %d, %v", released, err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	released, err = client.ReleaseExpired(context.Background(), "MESSAGE")
+	if err != nil || released != 1 {
+		t.Fatalf("expected 1 claim released after lease expiry, got %d, %v", released, err)
+	}
+	row, _ := backend.Row("event-1")
+	if row.Status != "PENDING" || row.Owner != "" {
+		t.Errorf("expected row back to PENDING without owner, got %s/%s", row.Status, row.Owner)
+	}
+}
+
+func TestClaim_ExpiredLeaseCanBeReclaimed(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	backend := outboxtest.NewBackend()
+	backend.SetClock(func() time.Time { return now })
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{})
+	a := newTestClient(t, backend, "replica-a")
+	b := newTestClient(t, backend, "replica-b")
+
+	a.Claim(context.Background(), []ClaimTarget{{EventId: "event-1", Version: 1}})
+	now = now.Add(2 * time.Minute)
+	won, err := b.Claim(context.Background(), []ClaimTarget{{EventId: "event-1", Version: 2}})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !won["event-1"] {
+		t.Error("expected expired claim to be taken over")
+	}
+}
+
+// unimplementedPatchClient answers every claim and batch RPC with
+// Unimplemented, like a backend that predates them.
+type unimplementedPatchClient struct {
+	outboxPb.PatchOutboxControllerClient
+	claimCalls int
+	batchCalls int
+	sent       []string
+	failed     []string
+}
+
+func (c *unimplementedPatchClient) PatchOutboxMarkAsSentBatchUseCase(context.Context, *outboxPb.MarkBatchRequest, ...grpc.CallOption) (*outboxPb.MarkBatchResponse, error) {
+	c.batchCalls++
+	return nil, status.Error(codes.Unimplemented, "unknown method")
+}
+
+func (c *unimplementedPatchClient) PatchOutboxMarkAsFailedBatchUseCase(context.Context, *outboxPb.MarkFailedBatchRequest, ...grpc.CallOption) (*outboxPb.MarkBatchResponse, error) {
+	c.batchCalls++
+	return nil, status.Error(codes.Unimplemented, "unknown method")
+}
+
+func (c *unimplementedPatchClient) PatchOutboxMarkAsSentUseCase(_ context.Context, req *outboxPb.MarkRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.sent = append(c.sent, req.EventId)
+	return &emptypb.Empty{}, nil
+}
+
+func (c *unimplementedPatchClient) PatchOutboxMarkAsFailedUseCase(_ context.Context, req *outboxPb.MarkFailedRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.failed = append(c.failed, req.EventId)
+	return &emptypb.Empty{}, nil
+}
+
+func (c *unimplementedPatchClient) PatchOutboxClaimUseCase(context.Context, *outboxPb.ClaimRequest, ...grpc.CallOption) (*outboxPb.ClaimResponse, error) {
+	c.claimCalls++
+	return nil, status.Error(codes.Unimplemented, "unknown method")
+}
+
+func TestClaim_UnimplementedBackendClaimsEverything(t *testing.T) {
+	patch := &unimplementedPatchClient{}
+	client := NewClient(patch, "replica-a", time.Minute)
+	targets := []ClaimTarget{{EventId: "event-1", Version: 1}, {EventId: "event-2", Version: 1}}
+
+	won, err := client.Claim(context.Background(), targets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.Claim(context.Background(), targets)
+
+	if len(won) != 2 {
+		t.Errorf("expected every row treated as claimed, got %v", won)
+	}
+	if patch.claimCalls != 1 {
+		t.Errorf("expected the claim RPC to be skipped once unsupported, got %d calls", patch.claimCalls)
+	}
+}
+
+func TestMarkSent_UsesOneBatchCall(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{})
+	backend.AddMessage("event-2", &outboxPb.MessagePayload{})
+	client := newTestClient(t, backend, "replica-a")
+
+	failed, err := client.MarkSent(context.Background(), []string{"event-1", "event-2"})
+
+	if err != nil || len(failed) != 0 {
+		t.Fatalf("unexpected failure: %v, %v", failed, err)
+	}
+	if backend.BatchCalls() != 1 {
+		t.Errorf("expected 1 batch call, got %d", backend.BatchCalls())
+	}
+	for _, id := range []string{"event-1", "event-2"} {
+		if row, _ := backend.Row(id); row.Status != "SENT" {
+			t.Errorf("expected %s SENT, got %s", id, row.Status)
+		}
+	}
+}
+
+func TestMarkSent_SplitsLargeBatches(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	eventIds := make([]string, markBatchSize+1)
+	for i := range eventIds {
+		eventIds[i] = fmt.Sprintf("event-%d", i)
+		backend.AddMessage(eventIds[i], &outboxPb.MessagePayload{})
+	}
+	client := newTestClient(t, backend, "replica-a")
+
+	if _, err := client.MarkSent(context.Background(), eventIds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if backend.BatchCalls() != 2 {
+		t.Errorf("expected 2 batch calls, got %d", backend.BatchCalls())
+	}
+}
+
+func TestMarkFailed_ReportsRejectedRows(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{})
+	client := newTestClient(t, backend, "replica-a")
+
+	failed, err := client.MarkFailed(context.Background(), []Failure{
+		{EventId: "event-1", Reason: "publish error"},
+		{EventId: "missing", Reason: "publish error"},
+	})
+
+	if !errors.Is(err, errRejected) {
+		t.Errorf("expected errRejected, got %v", err)
+	}
+	if len(failed) != 1 || failed[0] != "missing" {
+		t.Errorf("expected only missing to fail, got %v", failed)
+	}
+	row, _ := backend.Row("event-1")
+	if row.Status != "FAILED" || row.LastError != "publish error" {
+		t.Errorf("expected event-1 FAILED with reason, got %s/%q", row.Status, row.LastError)
+	}
+}
+
+func TestMarkSent_UnimplementedBatchFallsBackToPerEventCalls(t *testing.T) {
+	patch := &unimplementedPatchClient{}
+	client := NewClient(patch, "", 0)
+
+	failed, err := client.MarkSent(context.Background(), []string{"event-1", "event-2"})
+	if err != nil || len(failed) != 0 {
+		t.Fatalf("unexpected failure: %v, %v", failed, err)
+	}
+	client.MarkFailed(context.Background(), []Failure{{EventId: "event-3", Reason: "boom"}})
+
+	if patch.batchCalls != 1 {
+		t.Errorf("expected the batch RPC to be skipped once unsupported, got %d calls", patch.batchCalls)
+	}
+	if len(patch.sent) != 2 || len(patch.failed) != 1 {
+		t.Errorf("expected per-event calls, got sent=%v failed=%v", patch.sent, patch.failed)
+	}
+}
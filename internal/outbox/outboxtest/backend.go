@@ -0,0 +1,347 @@
+// Package outboxtest provides an in-memory hobom-backend outbox for tests.
+// It implements the find and patch gRPC services over an in-process
+// connection, including claims, leases and optimistic versioning.
+package outboxtest
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	outboxLogPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/log/outbox/v1"
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	statusPending  = "PENDING"
+	statusInFlight = "IN_FLIGHT"
+	statusSent     = "SENT"
+	statusFailed   = "FAILED"
+)
+
+// Row is the state of a single outbox row held by the Backend.
+type Row struct {
+	EventId     string
+	EventType   string
+	Status      string
+	Version     int32
+	Owner       string
+	LeaseUntil  time.Time
+	LastError   string
+	CreatedAt   time.Time
+	Message     *outboxPb.MessagePayload
+	Log         *outboxLogPb.HoBomLogPayload
+	SentCount   int
+	FailedCount int
+}
+
+// Backend is an in-memory outbox. The zero value is not usable; create one
+// with NewBackend.
+type Backend struct {
+	outboxPb.UnimplementedFindHoBomMessageOutboxControllerServer
+	outboxLogPb.UnimplementedFindHoBomLogOutboxControllerServer
+	outboxPb.UnimplementedPatchOutboxControllerServer
+
+	mu   sync.Mutex
+	rows map[string]*Row
+	now  func() time.Time
+	seq  int
+
+	batchCalls  int
+	markSentErr error
+}
+
+// NewBackend creates an empty Backend using the wall clock.
+func NewBackend() *Backend {
+	return &Backend{
+		rows: make(map[string]*Row),
+		now:  time.Now,
+	}
+}
+
+// SetClock replaces the clock used for createdAt and lease expiry.
+func (b *Backend) SetClock(now func() time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.now = now
+}
+
+// AddMessage inserts a PENDING message row.
+func (b *Backend) AddMessage(eventId string, payload *outboxPb.MessagePayload) {
+	b.add(&Row{EventId: eventId, EventType: "MESSAGE", Message: payload})
+}
+
+// AddLog inserts a PENDING log row.
+func (b *Backend) AddLog(eventId string, payload *outboxLogPb.HoBomLogPayload) {
+	b.add(&Row{EventId: eventId, EventType: "HOBOM_LOG", Log: payload})
+}
+
+func (b *Backend) add(row *Row) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	row.Status = statusPending
+	row.Version = 1
+	// 동일 시각에 생성된 Row 도 삽입 순서대로 정렬되도록 seq 만큼 시간을 더한다.
+	row.CreatedAt = b.now().Add(time.Duration(b.seq) * time.Microsecond)
+	b.rows[row.EventId] = row
+}
+
+// Row returns a copy of the row for eventId.
+func (b *Backend) Row(eventId string) (Row, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	row, ok := b.rows[eventId]
+	if !ok {
+		return Row{}, false
+	}
+	return *row, true
+}
+
+// Dial serves the Backend on an in-process listener and returns a client
+// connection to it. Both are closed when the test ends.
+func (b *Backend) Dial(t testing.TB) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	outboxPb.RegisterFindHoBomMessageOutboxControllerServer(srv, b)
+	outboxLogPb.RegisterFindHoBomLogOutboxControllerServer(srv, b)
+	outboxPb.RegisterPatchOutboxControllerServer(srv, b)
+	go srv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial fake outbox backend: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+// page returns rows of eventType in status, oldest first, after cursor.
+// The cursor is the position of the last returned row in that ordering.
+func (b *Backend) page(eventType, status string, limit int32, cursor string) ([]*Row, string) {
+	var matched []*Row
+	for _, row := range b.rows {
+		if row.EventType == eventType && row.Status == status {
+			matched = append(matched, row)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+		}
+		return matched[i].EventId < matched[j].EventId
+	})
+
+	start := 0
+	if cursor != "" {
+		after, _ := strconv.ParseInt(cursor, 10, 64)
+		for start < len(matched) && matched[start].CreatedAt.UnixNano() <= after {
+			start++
+		}
+	}
+	matched = matched[start:]
+	if limit <= 0 || int(limit) >= len(matched) {
+		return matched, ""
+	}
+	matched = matched[:limit]
+	return matched, strconv.FormatInt(matched[len(matched)-1].CreatedAt.UnixNano(), 10)
+}
+
+func (b *Backend) FindOutboxByEventTypeAndStatusUseCase(_ context.Context, req *outboxPb.Request) (*outboxPb.Response, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	rows, next := b.page(req.EventType, req.Status, req.Limit, req.Cursor)
+	res := &outboxPb.Response{NextCursor: next}
+	for _, row := range rows {
+		res.Items = append(res.Items, &outboxPb.QueryResult{
+			EventId:   row.EventId,
+			EventType: row.EventType,
+			Status:    row.Status,
+			Version:   row.Version,
+			CreatedAt: row.CreatedAt.Format(time.RFC3339Nano),
+			Payload:   row.Message,
+		})
+	}
+	return res, nil
+}
+
+func (b *Backend) FindLogOutboxByEventTypeAndStatusUseCase(_ context.Context, req *outboxLogPb.Request) (*outboxLogPb.Response, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	rows, next := b.page(req.EventType, req.Status, req.Limit, req.Cursor)
+	res := &outboxLogPb.Response{NextCursor: next}
+	for _, row := range rows {
+		res.Items = append(res.Items, &outboxLogPb.QueryResult{
+			EventId:   row.EventId,
+			EventType: row.EventType,
+			Status:    row.Status,
+			Version:   row.Version,
+			CreatedAt: row.CreatedAt.Format(time.RFC3339Nano),
+			Payload:   row.Log,
+		})
+	}
+	return res, nil
+}
+
+func (b *Backend) PatchOutboxMarkAsSentUseCase(_ context.Context, req *outboxPb.MarkRequest) (*emptypb.Empty, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.markSentErr != nil {
+		return nil, b.markSentErr
+	}
+	if !b.markSent(req.EventId) {
+		return nil, status.Errorf(codes.NotFound, "outbox %s not found", req.EventId)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (b *Backend) PatchOutboxMarkAsFailedUseCase(_ context.Context, req *outboxPb.MarkFailedRequest) (*emptypb.Empty, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.markFailed(req.EventId, req.ErrorMessage) {
+		return nil, status.Errorf(codes.NotFound, "outbox %s not found", req.EventId)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PatchOutboxMarkAsSentBatchUseCase marks every known row SENT and reports
+// unknown event IDs as rejected.
+func (b *Backend) PatchOutboxMarkAsSentBatchUseCase(_ context.Context, req *outboxPb.MarkBatchRequest) (*outboxPb.MarkBatchResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.batchCalls++
+	if b.markSentErr != nil {
+		return nil, b.markSentErr
+	}
+	res := &outboxPb.MarkBatchResponse{}
+	for _, eventId := range req.EventIds {
+		if !b.markSent(eventId) {
+			res.RejectedEventIds = append(res.RejectedEventIds, eventId)
+		}
+	}
+	return res, nil
+}
+
+// PatchOutboxMarkAsFailedBatchUseCase marks every known row FAILED and
+// reports unknown event IDs as rejected.
+func (b *Backend) PatchOutboxMarkAsFailedBatchUseCase(_ context.Context, req *outboxPb.MarkFailedBatchRequest) (*outboxPb.MarkBatchResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.batchCalls++
+	res := &outboxPb.MarkBatchResponse{}
+	for _, item := range req.Items {
+		if !b.markFailed(item.EventId, item.ErrorMessage) {
+			res.RejectedEventIds = append(res.RejectedEventIds, item.EventId)
+		}
+	}
+	return res, nil
+}
+
+// FailMarkSent makes every SENT patch, single or batched, fail with err until
+// it is called again with nil.
+func (b *Backend) FailMarkSent(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.markSentErr = err
+}
+
+// BatchCalls returns the number of batch patch calls served so far.
+func (b *Backend) BatchCalls() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.batchCalls
+}
+
+func (b *Backend) markSent(eventId string) bool {
+	row, ok := b.rows[eventId]
+	if !ok {
+		return false
+	}
+	row.Status = statusSent
+	row.Version++
+	row.Owner = ""
+	row.SentCount++
+	return true
+}
+
+func (b *Backend) markFailed(eventId, reason string) bool {
+	row, ok := b.rows[eventId]
+	if !ok {
+		return false
+	}
+	row.Status = statusFailed
+	row.Version++
+	row.Owner = ""
+	row.LastError = reason
+	row.FailedCount++
+	return true
+}
+
+func (b *Backend) PatchOutboxClaimUseCase(_ context.Context, req *outboxPb.ClaimRequest) (*outboxPb.ClaimResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	res := &outboxPb.ClaimResponse{}
+	for _, target := range req.Targets {
+		row, ok := b.rows[target.EventId]
+		if !ok || row.Version != target.Version {
+			continue
+		}
+		claimable := row.Status == statusPending ||
+			(row.Status == statusInFlight && !now.Before(row.LeaseUntil))
+		if !claimable {
+			continue
+		}
+		row.Status = statusInFlight
+		row.Owner = req.Owner
+		row.LeaseUntil = now.Add(time.Duration(req.LeaseSeconds) * time.Second)
+		row.Version++
+		res.ClaimedEventIds = append(res.ClaimedEventIds, row.EventId)
+	}
+	return res, nil
+}
+
+func (b *Backend) PatchOutboxReleaseExpiredClaimsUseCase(_ context.Context, req *outboxPb.ReleaseExpiredClaimsRequest) (*outboxPb.ReleaseExpiredClaimsResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	res := &outboxPb.ReleaseExpiredClaimsResponse{}
+	for _, row := range b.rows {
+		if row.EventType != req.EventType || row.Status != statusInFlight || now.Before(row.LeaseUntil) {
+			continue
+		}
+		row.Status = statusPending
+		row.Owner = ""
+		row.Version++
+		res.Released++
+	}
+	return res, nil
+}
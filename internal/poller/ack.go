@@ -0,0 +1,134 @@
+package poller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	redis "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
+)
+
+// acknowledgeSent journals eventIds as published, then marks them SENT and
+// removes the acknowledged ones from the journal. IDs that could not be
+// marked stay journaled for the reconciler and are returned with the error.
+func acknowledgeSent(ctx context.Context, client *outbox.Client, journal redis.AckJournal, eventIds []string) ([]string, error) {
+	// SENT 업데이트에 실패하더라도 재발행되지 않도록, 발행된 Event 를 먼저 기록한다.
+	if err := journal.Record(ctx, eventIds); err != nil {
+		slog.Error("failed to journal published outbox events", "count", len(eventIds), "err", err)
+	}
+
+	failed, markErr := client.MarkSent(ctx, eventIds)
+	if err := journal.Remove(ctx, without(eventIds, failed)); err != nil {
+		slog.Warn("failed to remove acknowledged outbox events from journal", "err", err)
+	}
+	return failed, markErr
+}
+
+// skipUnacked drops rows that were already published but are still waiting
+// for their SENT acknowledgement, so they are not published twice.
+func skipUnacked[T outboxRow](ctx context.Context, journal redis.AckJournal, rows []T) ([]T, error) {
+	eventIds := make([]string, len(rows))
+	for i, row := range rows {
+		eventIds[i] = row.GetEventId()
+	}
+
+	unacked, err := journal.Contains(ctx, eventIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(unacked) == 0 {
+		return rows, nil
+	}
+
+	kept := make([]T, 0, len(rows)-len(unacked))
+	for _, row := range rows {
+		if !unacked[row.GetEventId()] {
+			kept = append(kept, row)
+		}
+	}
+	slog.Info("skipping published outbox rows awaiting acknowledgement", "count", len(rows)-len(kept))
+	return kept, nil
+}
+
+// runAckReconciler retries the SENT acknowledgement of journaled events until
+// ctx is cancelled. While acknowledgements keep failing the wait doubles from
+// interval up to maxInterval. Entries older than retention are dropped so
+// their rows are published again instead of being held back forever.
+func runAckReconciler(ctx context.Context, client *outbox.Client, journal redis.AckJournal, interval, maxInterval, retention time.Duration) {
+	wait := interval
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			if reconcileAcks(ctx, client, journal, retention) {
+				wait = interval
+			} else {
+				wait = min(wait*2, maxInterval)
+			}
+			timer.Reset(wait)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reconcileAcks makes one pass over the journal and reports whether every
+// entry was acknowledged or expired.
+func reconcileAcks(ctx context.Context, client *outbox.Client, journal redis.AckJournal, retention time.Duration) bool {
+	entries, err := journal.List(ctx)
+	if err != nil {
+		slog.Error("failed to list unacknowledged outbox events", "err", err)
+		return false
+	}
+
+	var pending, expired []string
+	cutoff := time.Now().Add(-retention)
+	for eventId, recordedAt := range entries {
+		if recordedAt.Before(cutoff) {
+			expired = append(expired, eventId)
+			continue
+		}
+		pending = append(pending, eventId)
+	}
+
+	if len(expired) > 0 {
+		slog.Error("giving up on outbox acknowledgement, rows will be published again", "eventIds", expired)
+		if err := journal.Remove(ctx, expired); err != nil {
+			slog.Warn("failed to remove expired outbox events from journal", "err", err)
+		}
+	}
+	if len(pending) == 0 {
+		return true
+	}
+
+	failed, markErr := client.MarkSent(ctx, pending)
+	if err := journal.Remove(ctx, without(pending, failed)); err != nil {
+		slog.Warn("failed to remove acknowledged outbox events from journal", "err", err)
+	}
+	if markErr != nil {
+		slog.Warn("outbox acknowledgement still failing", "count", len(failed), "err", markErr)
+		return false
+	}
+	slog.Info("acknowledged journaled outbox events", "count", len(pending))
+	return true
+}
+
+// without returns the eventIds not listed in exclude, in order.
+func without(eventIds, exclude []string) []string {
+	if len(exclude) == 0 {
+		return eventIds
+	}
+	skip := make(map[string]bool, len(exclude))
+	for _, eventId := range exclude {
+		skip[eventId] = true
+	}
+	kept := make([]string, 0, len(eventIds))
+	for _, eventId := range eventIds {
+		if !skip[eventId] {
+			kept = append(kept, eventId)
+		}
+	}
+	return kept
+}
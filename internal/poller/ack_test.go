@@ -0,0 +1,182 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox/outboxtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryAckJournal is an in-memory AckJournal that is safe for concurrent use.
+type memoryAckJournal struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+	// containsErr simulates a Redis outage on Contains.
+	containsErr error
+}
+
+func newMemoryAckJournal() *memoryAckJournal {
+	return &memoryAckJournal{entries: make(map[string]time.Time)}
+}
+
+func (j *memoryAckJournal) Record(_ context.Context, eventIds []string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, eventId := range eventIds {
+		j.entries[eventId] = time.Now()
+	}
+	return nil
+}
+
+func (j *memoryAckJournal) Remove(_ context.Context, eventIds []string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, eventId := range eventIds {
+		delete(j.entries, eventId)
+	}
+	return nil
+}
+
+func (j *memoryAckJournal) Contains(_ context.Context, eventIds []string) (map[string]bool, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.containsErr != nil {
+		return nil, j.containsErr
+	}
+	found := make(map[string]bool)
+	for _, eventId := range eventIds {
+		if _, ok := j.entries[eventId]; ok {
+			found[eventId] = true
+		}
+	}
+	return found, nil
+}
+
+func (j *memoryAckJournal) List(_ context.Context) (map[string]time.Time, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entries := make(map[string]time.Time, len(j.entries))
+	for eventId, at := range j.entries {
+		entries[eventId] = at
+	}
+	return entries, nil
+}
+
+func TestMessagePoller_UnackedEventsAreNotRepublished(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	backend := outboxtest.NewBackend()
+	backend.SetClock(func() time.Time { return now })
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{Title: "title", Recipient: "user-1"})
+	backend.FailMarkSent(status.Error(codes.Unavailable, "backend down"))
+
+	conn := backend.Dial(t)
+	client := outbox.NewClient(outboxPb.NewPatchOutboxControllerClient(conn), "replica-a", time.Minute)
+	journal := newMemoryAckJournal()
+	pub := &recordingPublisher{}
+	p := NewMessagePoller(conn, pub, nil, journal, DefaultConfig())
+
+	p.Poll(context.Background())
+	if found, _ := journal.Contains(context.Background(), []string{"event-1"}); !found["event-1"] {
+		t.Fatal("expected unacknowledged event to be journaled")
+	}
+
+	// 리스가 만료되어 Row 가 PENDING 으로 돌아와도, 다시 발행되지 않아야 한다.
+	now = now.Add(2 * time.Minute)
+	if _, err := client.ReleaseExpired(context.Background(), EventTypeHoBomMessage); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Poll(context.Background())
+	if n := pub.countByKey()["event-1"]; n != 1 {
+		t.Fatalf("expected event published once, got %d", n)
+	}
+
+	if reconcileAcks(context.Background(), client, journal, time.Hour) {
+		t.Error("expected reconcile to report failure while the backend is down")
+	}
+	backend.FailMarkSent(nil)
+	if !reconcileAcks(context.Background(), client, journal, time.Hour) {
+		t.Error("expected reconcile to succeed once the backend recovers")
+	}
+
+	if row, _ := backend.Row("event-1"); row.Status != "SENT" {
+		t.Errorf("expected row SENT after reconcile, got %s", row.Status)
+	}
+	if entries, _ := journal.List(context.Background()); len(entries) != 0 {
+		t.Errorf("expected journal to be empty, got %v", entries)
+	}
+}
+
+func TestMessagePoller_UnackedPageIsNotCounted(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{Title: "title", Recipient: "user-1"})
+	backend.AddMessage("event-2", &outboxPb.MessagePayload{Title: "title", Recipient: "user-2"})
+	journal := newMemoryAckJournal()
+	journal.Record(context.Background(), []string{"event-1", "event-2"})
+
+	cfg := DefaultConfig()
+	cfg.PageSize = 2
+	cfg.MaxPerCycle = 2
+	pub := &recordingPublisher{}
+	p := NewMessagePoller(backend.Dial(t), pub, nil, journal, cfg)
+
+	// 페이지 전체가 SENT 반영을 기다리는 Row 라면, 처리한 Row 가 없는 Cycle 이므로 바로 다시 Poll 하지 않아야 한다.
+	res := p.Poll(context.Background())
+	if res.Processed != 0 || res.More || len(pub.countByKey()) != 0 {
+		t.Fatalf("expected a cycle with nothing processed, got %+v and %v", res, pub.countByKey())
+	}
+	if delay := newPollScheduler(cfg).next(res); delay == 0 {
+		t.Error("expected the scheduler to wait after a page of unacked rows")
+	}
+
+	// 건너뛴 Row 는 MaxPerCycle 에 포함되지 않으므로, 그 뒤의 PENDING Row 들이 같은 Cycle 에 발행되어야 한다.
+	backend.AddMessage("event-3", &outboxPb.MessagePayload{Title: "title", Recipient: "user-3"})
+	backend.AddMessage("event-4", &outboxPb.MessagePayload{Title: "title", Recipient: "user-4"})
+	res = p.Poll(context.Background())
+	counts := pub.countByKey()
+	if res.Processed != 2 || len(counts) != 2 || counts["event-3"] != 1 || counts["event-4"] != 1 {
+		t.Errorf("expected event-3 and event-4 published, got %+v and %v", res, counts)
+	}
+}
+
+func TestMessagePoller_AckJournalOutageKeepsPublishing(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{Title: "title", Recipient: "user-1"})
+	journal := newMemoryAckJournal()
+	journal.containsErr = errors.New("redis: connection refused")
+	pub := &recordingPublisher{}
+
+	res := NewMessagePoller(backend.Dial(t), pub, nil, journal, DefaultConfig()).Poll(context.Background())
+
+	if res.Err != nil || res.Processed != 1 || pub.countByKey()["event-1"] != 1 {
+		t.Errorf("expected the row published despite the journal outage, got %+v", res)
+	}
+}
+
+func TestReconcileAcks_DropsExpiredEntries(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.FailMarkSent(status.Error(codes.Unavailable, "backend down"))
+	client := outbox.NewClient(outboxPb.NewPatchOutboxControllerClient(backend.Dial(t)), "", 0)
+	journal := newMemoryAckJournal()
+	journal.entries["event-old"] = time.Now().Add(-2 * time.Hour)
+
+	if !reconcileAcks(context.Background(), client, journal, time.Hour) {
+		t.Error("expected reconcile to succeed after dropping expired entries")
+	}
+	if entries, _ := journal.List(context.Background()); len(entries) != 0 {
+		t.Errorf("expected expired entry dropped, got %v", entries)
+	}
+}
+
+func TestWithout(t *testing.T) {
+	got := without([]string{"a", "b", "c"}, []string{"b"})
+	if len(got) != 2 || got[0] != "a" || got[1] != "c" {
+		t.Errorf("expected [a c], got %v", got)
+	}
+}
@@ -0,0 +1,66 @@
+package poller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
+)
+
+// outboxRow is the part of a fetched outbox row needed to claim it.
+type outboxRow interface {
+	GetEventId() string
+	GetVersion() int32
+}
+
+// claimRows claims the fetched rows for this instance and returns only the
+// rows it won, in fetch order. Rows claimed by another replica, or changed
+// since they were fetched, are dropped from this cycle.
+func claimRows[T outboxRow](ctx context.Context, client *outbox.Client, rows []T) ([]T, error) {
+	targets := make([]outbox.ClaimTarget, len(rows))
+	for i, row := range rows {
+		targets[i] = outbox.ClaimTarget{EventId: row.GetEventId(), Version: row.GetVersion()}
+	}
+
+	claimed, err := client.Claim(ctx, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	won := make([]T, 0, len(claimed))
+	for _, row := range rows {
+		if claimed[row.GetEventId()] {
+			won = append(won, row)
+		}
+	}
+	if lost := len(rows) - len(won); lost > 0 {
+		slog.Info("skipping outbox rows claimed elsewhere", "count", lost)
+	}
+	return won, nil
+}
+
+// runReaper returns IN_FLIGHT rows whose lease expired, e.g. because the
+// replica holding them crashed, to PENDING every interval until ctx is
+// cancelled.
+func runReaper(ctx context.Context, client *outbox.Client, interval time.Duration, eventTypes ...string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, eventType := range eventTypes {
+				released, err := client.ReleaseExpired(ctx, eventType)
+				if err != nil {
+					slog.Error("failed to release expired outbox claims", "eventType", eventType, "err", err)
+					continue
+				}
+				if released > 0 {
+					slog.Warn("released expired outbox claims", "eventType", eventType, "count", released)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
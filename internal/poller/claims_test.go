@@ -0,0 +1,87 @@
+package poller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox/outboxtest"
+)
+
+// recordingPublisher records every published event and is safe for concurrent use.
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []publisher.Event
+}
+
+func (r *recordingPublisher) Publish(ctx context.Context, event publisher.Event) error {
+	return r.PublishBatch(ctx, []publisher.Event{event})[0]
+}
+
+func (r *recordingPublisher) PublishBatch(_ context.Context, events []publisher.Event) []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, events...)
+	return make([]error, len(events))
+}
+
+func (r *recordingPublisher) Close() error { return nil }
+
+func (r *recordingPublisher) countByKey() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := make(map[string]int)
+	for _, e := range r.events {
+		counts[e.Key]++
+	}
+	return counts
+}
+
+func TestMessagePoller_ConcurrentReplicasPublishEachEventOnce(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	for i := 0; i < 200; i++ {
+		backend.AddMessage(fmt.Sprintf("event-%03d", i), &outboxPb.MessagePayload{
+			Title:     "title",
+			Recipient: fmt.Sprintf("user-%d@hobom.dev", i%7),
+		})
+	}
+
+	pub := &recordingPublisher{}
+	cfg := DefaultConfig()
+	cfg.PageSize = 20
+	replicas := []Poller{}
+	for _, id := range []string{"replica-a", "replica-b", "replica-c"} {
+		cfg.InstanceId = id
+		replicas = append(replicas, NewMessagePoller(backend.Dial(t), pub, nil, newMemoryAckJournal(), cfg))
+	}
+
+	var wg sync.WaitGroup
+	for _, p := range replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p.Poll(context.Background()).Processed > 0 {
+			}
+		}()
+	}
+	wg.Wait()
+
+	counts := pub.countByKey()
+	if len(counts) != 200 {
+		t.Errorf("expected all 200 events published, got %d", len(counts))
+	}
+	for key, n := range counts {
+		if n != 1 {
+			t.Errorf("event %s published %d times", key, n)
+		}
+	}
+	for i := 0; i < 200; i++ {
+		row, _ := backend.Row(fmt.Sprintf("event-%03d", i))
+		if row.Status != OutboxSent || row.SentCount != 1 {
+			t.Errorf("event-%03d: expected SENT once, got %s (sent %d times)", i, row.Status, row.SentCount)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package poller
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde"
+)
+
+// Config holds the tunables shared by the pollers started via StartAllPollers.
+type Config struct {
+	// LogKeyStrategy selects the Kafka key used to group log events.
+	LogKeyStrategy LogKeyStrategy
+
+	// Concurrency is the number of workers that publish and settle the
+	// messages of a single poll cycle in parallel.
+	Concurrency int
+	// BatchSize is the maximum number of messages a worker publishes in one
+	// PublishBatch call before settling them and taking the next chunk.
+	BatchSize int
+	// OrderByRecipient routes messages for the same recipient to the same
+	// worker so they are published and settled in fetch order.
+	OrderByRecipient bool
+
+	// PollInterval is the wait between cycles while the backlog is small.
+	PollInterval time.Duration
+	// MaxPollInterval caps the wait after consecutive empty or failed cycles.
+	MaxPollInterval time.Duration
+	// PageSize is the number of rows requested per outbox fetch. A poller
+	// that processes a full page starts its next cycle immediately.
+	PageSize int
+	// MaxPerCycle caps the rows a single poll cycle processes across pages;
+	// rows skipped while awaiting acknowledgement are not counted. Zero walks
+	// until the backend has no more rows.
+	MaxPerCycle int
+	// PollJitter randomises each wait by up to ±PollJitter of its length so
+	// replicas do not poll in lockstep.
+	PollJitter float64
+
+	// InstanceId identifies this processor replica as the owner of claimed rows.
+	InstanceId string
+	// LeaseDuration is how long a claimed row stays IN_FLIGHT before another
+	// replica may claim it again.
+	LeaseDuration time.Duration
+	// ReaperInterval is how often expired claims are returned to PENDING.
+	ReaperInterval time.Duration
+
+	// AckRetryInterval is how often events that were published but not yet
+	// marked SENT are acknowledged again.
+	AckRetryInterval time.Duration
+	// MaxAckRetryInterval caps the backoff between failing acknowledgement
+	// retries.
+	MaxAckRetryInterval time.Duration
+	// AckRetention is how long an unacknowledged event keeps its row from
+	// being published again. After that it is dropped from the journal and
+	// the row is published on the next cycle.
+	AckRetention time.Duration
+
+	// Serializer encodes the values of published events. Nil encodes them
+	// as JSON.
+	Serializer serde.Serializer
+
+	// RestartBackoff is the wait before a poll loop is restarted after a
+	// cycle panicked. It doubles with every consecutive panic.
+	RestartBackoff time.Duration
+	// MaxRestartBackoff caps the wait between restarts.
+	MaxRestartBackoff time.Duration
+	// MaxConsecutivePanics is the number of cycles in a row a loop may panic
+	// before the service reports itself not ready. Zero never reports it.
+	MaxConsecutivePanics int
+
+	// DrainTimeout bounds how long shutdown waits for poll cycles to publish
+	// and settle the rows they have claimed.
+	DrainTimeout time.Duration
+}
+
+// DefaultConfig returns a Config with production-safe defaults:
+//   - LogKeyStrategy: TRACE_ID (logs of one request stay ordered on one partition)
+//   - Concurrency: 8 workers per message poll cycle
+//   - BatchSize: 100 messages per publish
+//   - OrderByRecipient: true
+//   - PollInterval: 5s, backing off to MaxPollInterval 1m while idle
+//   - PageSize: 500 rows, MaxPerCycle: 5000 rows
+//   - PollJitter: 0.2 (±20%)
+//   - InstanceId: the host name
+//   - LeaseDuration: 2m, ReaperInterval: 30s
+//   - AckRetryInterval: 10s, backing off to MaxAckRetryInterval 5m
+//   - AckRetention: 24h
+//   - Serializer: JSON
+//   - RestartBackoff: 1s, backing off to MaxRestartBackoff 1m
+//   - MaxConsecutivePanics: 3
+//   - DrainTimeout: 20s (within the default 30s Kubernetes grace period)
+func DefaultConfig() Config {
+	return Config{
+		LogKeyStrategy:   LogKeyByTraceId,
+		Concurrency:      8,
+		BatchSize:        100,
+		OrderByRecipient: true,
+		PollInterval:     5 * time.Second,
+		MaxPollInterval:  time.Minute,
+		PageSize:         500,
+		MaxPerCycle:      5000,
+		PollJitter:       0.2,
+		InstanceId:       defaultInstanceId(),
+		LeaseDuration:    2 * time.Minute,
+		ReaperInterval:   30 * time.Second,
+
+		AckRetryInterval:    10 * time.Second,
+		MaxAckRetryInterval: 5 * time.Minute,
+		AckRetention:        24 * time.Hour,
+
+		Serializer: serde.JSONSerializer{},
+
+		RestartBackoff:       time.Second,
+		MaxRestartBackoff:    time.Minute,
+		MaxConsecutivePanics: 3,
+
+		DrainTimeout: 20 * time.Second,
+	}
+}
+
+// serializer returns the configured Serializer, defaulting to JSON.
+func (c Config) serializer() serde.Serializer {
+	if c.Serializer == nil {
+		return serde.JSONSerializer{}
+	}
+	return c.Serializer
+}
+
+// defaultInstanceId returns the host name, which is the pod name when running
+// in Kubernetes, falling back to the process ID.
+func defaultInstanceId() string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return fmt.Sprintf("hobom-event-processor-%d", os.Getpid())
+}
@@ -10,6 +10,8 @@ const (
 
 	// OutboxPending is the initial state of an outbox event awaiting dispatch.
 	OutboxPending = "PENDING"
+	// OutboxInFlight indicates the event is claimed by a processor instance until its lease expires.
+	OutboxInFlight = "IN_FLIGHT"
 	// OutboxSent indicates the event was successfully published to Kafka.
 	OutboxSent = "SENT"
 	// OutboxFailed indicates the event could not be published after all retries.
@@ -30,6 +32,15 @@ const (
 	HoBomTodayMenuDLQPrefix = "dlq:menu:"
 	// HoBomLogDLQPrefix is the Redis key prefix for log-event DLQ entries.
 	HoBomLogDLQPrefix = "dlq:log:"
+	// HoBomInvalidMessageDLQPrefix is the Redis key prefix for message events
+	// rejected by validation, kept apart from publish failures.
+	HoBomInvalidMessageDLQPrefix = "dlq:invalid:menu:"
+	// HoBomInvalidLogDLQPrefix is the Redis key prefix for log events
+	// rejected by validation.
+	HoBomInvalidLogDLQPrefix = "dlq:invalid:log:"
+	// InvalidDLQPrefix is the common prefix of every DLQ key for events
+	// rejected by validation.
+	InvalidDLQPrefix = "dlq:invalid:"
 
 	// TTL72Hours is the retention period for DLQ entries.
 	TTL72Hours = 72 * time.Hour
@@ -2,17 +2,179 @@ package poller
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
+	"errors"
 	"log/slog"
+	"strings"
+	"time"
 
+	publisher "github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
 	redisClient "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/segmentio/kafka-go"
 )
 
-// saveDLQ persists a failed event payload to the DLQ store.
+// DLQEntry is the value stored for every DLQ key. Besides the payload it
+// keeps the Kafka key, topic and headers the event was published with, so a
+// replay is delivered as the same event.
+//
+// JSON payloads are stored as is in Payload. Protobuf and Avro payloads are
+// stored base64-encoded in Value instead.
+type DLQEntry struct {
+	Key     string          `json:"key"`
+	Topic   string          `json:"topic"`
+	Headers []DLQHeader     `json:"headers,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	Value   []byte          `json:"value,omitempty"`
+	// Reason is why validation rejected the event. It is empty for events
+	// that failed to publish.
+	Reason string `json:"reason,omitempty"`
+	// Error is why publishing the event failed. It is empty for events
+	// rejected by validation.
+	Error string `json:"error,omitempty"`
+	// FailedAt is when the event was saved to the DLQ. It is zero for
+	// entries saved before it was recorded.
+	FailedAt time.Time `json:"failedAt,omitzero"`
+	// Version counts the edits of the payload; version 0 is the event as it
+	// failed. EditedAt and EditedBy describe the edit that stored it.
+	Version  int       `json:"version,omitempty"`
+	EditedAt time.Time `json:"editedAt,omitzero"`
+	EditedBy string    `json:"editedBy,omitempty"`
+	// History holds the versions replaced by edits, oldest first.
+	History []DLQRevision `json:"history,omitempty"`
+}
+
+// DLQRevision is a version of a DLQEntry payload replaced by an edit.
+type DLQRevision struct {
+	Version  int             `json:"version"`
+	Payload  json.RawMessage `json:"payload"`
+	Reason   string          `json:"reason,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	EditedAt time.Time       `json:"editedAt,omitzero"`
+	EditedBy string          `json:"editedBy,omitempty"`
+}
+
+// DLQHeader is a Kafka header of a DLQEntry.
+type DLQHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewDLQEntry captures event as a DLQEntry.
+func NewDLQEntry(event publisher.Event) DLQEntry {
+	headers := make([]DLQHeader, len(event.Headers))
+	for i, h := range event.Headers {
+		headers[i] = DLQHeader{Key: h.Key, Value: string(h.Value)}
+	}
+	entry := DLQEntry{
+		Key:     event.Key,
+		Topic:   event.Topic,
+		Headers: headers,
+	}
+	if isJSON(event) {
+		entry.Payload = json.RawMessage(event.Value)
+	} else {
+		entry.Value = event.Value
+	}
+	return entry
+}
+
+// isJSON reports whether the event value is JSON, judging by its content-type
+// header. Events without the header predate binary encodings and are JSON.
+func isJSON(event publisher.Event) bool {
+	contentType := publisher.HeaderValue(event.Headers, publisher.HeaderContentType)
+	return (contentType == "" || strings.HasPrefix(contentType, "application/json")) && json.Valid(event.Value)
+}
+
+// Data returns the stored payload bytes.
+func (e DLQEntry) Data() []byte {
+	if e.Payload != nil {
+		return e.Payload
+	}
+	return e.Value
+}
+
+// Event rebuilds the Kafka event stored in the entry. The timestamp is left
+// for the caller to set.
+func (e DLQEntry) Event() publisher.Event {
+	headers := make([]kafka.Header, len(e.Headers))
+	for i, h := range e.Headers {
+		headers[i] = kafka.Header{Key: h.Key, Value: []byte(h.Value)}
+	}
+	return publisher.Event{
+		Key:     e.Key,
+		Value:   e.Data(),
+		Headers: headers,
+		Topic:   e.Topic,
+	}
+}
+
+// Revision returns the current version of the entry as a DLQRevision.
+func (e DLQEntry) Revision() DLQRevision {
+	return DLQRevision{
+		Version:  e.Version,
+		Payload:  e.Payload,
+		Reason:   e.Reason,
+		Error:    e.Error,
+		EditedAt: e.EditedAt,
+		EditedBy: e.EditedBy,
+	}
+}
+
+// Edit replaces the payload with a new version edited by editor at the given
+// time, keeping the current one in History. The reason and error of the
+// current version do not apply to the new one and are cleared.
+func (e *DLQEntry) Edit(payload json.RawMessage, editor string, at time.Time) {
+	e.History = append(e.History, e.Revision())
+	e.Payload = payload
+	e.Reason = ""
+	e.Error = ""
+	e.Version++
+	e.EditedAt = at
+	e.EditedBy = editor
+}
+
+// DecodeDLQEntry decodes a stored DLQ value. Values saved before entries
+// were wrapped hold only the JSON payload; they are returned as an entry with
+// no key, topic or headers.
+func DecodeDLQEntry(data []byte) (DLQEntry, error) {
+	if !json.Valid(data) {
+		return DLQEntry{}, errors.New("DLQ value is not valid JSON")
+	}
+
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err == nil && (probe["payload"] != nil || probe["value"] != nil) {
+		var entry DLQEntry
+		err := json.Unmarshal(data, &entry)
+		return entry, err
+	}
+	return DLQEntry{Payload: json.RawMessage(data)}, nil
+}
+
+// saveDLQ persists an event that failed to publish with cause to the DLQ
+// store.
 // Key format: dlq:[category]:[event-id], TTL: 72h.
-func saveDLQ(store redisClient.DLQStore, ctx context.Context, prefix, eventId string, value []byte) {
-	key := fmt.Sprintf("%s:%s", prefix, eventId)
-	if err := store.Save(ctx, key, value, TTL72Hours); err != nil {
+func saveDLQ(store redisClient.DLQStore, ctx context.Context, prefix, eventId string, event publisher.Event, cause error) {
+	entry := NewDLQEntry(event)
+	entry.Error = cause.Error()
+	saveDLQEntry(store, ctx, prefix, eventId, entry)
+}
+
+// saveInvalid persists an event rejected by validation to the DLQ store,
+// together with the reason. prefix is one of the dlq:invalid: prefixes.
+func saveInvalid(store redisClient.DLQStore, ctx context.Context, prefix, eventId string, event publisher.Event, cause error) {
+	entry := NewDLQEntry(event)
+	entry.Reason = cause.Error()
+	saveDLQEntry(store, ctx, prefix, eventId, entry)
+}
+
+func saveDLQEntry(store redisClient.DLQStore, ctx context.Context, prefix, eventId string, entry DLQEntry) {
+	entry.FailedAt = time.Now().UTC()
+	value, err := json.Marshal(entry)
+	if err != nil {
+		slog.Error("failed to marshal DLQ entry", "eventId", eventId, "err", err)
+		return
+	}
+	if err := store.Save(ctx, prefix+eventId, value, TTL72Hours); err != nil {
 		slog.Error("failed to save DLQ", "eventId", eventId, "err", err)
 	}
 }
@@ -0,0 +1,161 @@
+package poller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	publisher "github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	redisClient "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/segmentio/kafka-go"
+)
+
+// memoryDLQStore is an in-memory DLQStore.
+type memoryDLQStore struct {
+	data map[string][]byte
+}
+
+func (m *memoryDLQStore) Save(_ context.Context, key string, payload []byte, _ time.Duration) error {
+	m.data[key] = payload
+	return nil
+}
+
+func (m *memoryDLQStore) Get(_ context.Context, key string) ([]byte, error) {
+	return m.data[key], nil
+}
+
+func (m *memoryDLQStore) Delete(_ context.Context, key string) error {
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memoryDLQStore) List(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *memoryDLQStore) Scan(context.Context, string, func([]string) error) error {
+	return nil
+}
+
+func (m *memoryDLQStore) GetMany(context.Context, []string) ([]redisClient.DLQValue, error) {
+	return nil, nil
+}
+
+func (m *memoryDLQStore) TTL(context.Context, string) (time.Duration, error) {
+	return TTL72Hours, nil
+}
+
+func (m *memoryDLQStore) Update(_ context.Context, key string, fn func([]byte) ([]byte, error)) error {
+	updated, err := fn(m.data[key])
+	if err != nil {
+		return err
+	}
+	m.data[key] = updated
+	return nil
+}
+
+func TestSaveDLQ_StoresEnvelopeUnderSingleColonKey(t *testing.T) {
+	store := &memoryDLQStore{data: make(map[string][]byte)}
+	event := publisher.Event{
+		Key:     "trace-1",
+		Value:   []byte(`[{"level":"INFO"}]`),
+		Headers: []kafka.Header{{Key: publisher.HeaderEventId, Value: []byte("event-1")}},
+		Topic:   HoBomLog,
+	}
+
+	saveDLQ(store, context.Background(), HoBomLogDLQPrefix, "event-1", event, errors.New("kafka: write timeout"))
+
+	data, ok := store.data["dlq:log:event-1"]
+	if !ok {
+		t.Fatalf("expected key dlq:log:event-1, got %v", store.data)
+	}
+	entry, err := DecodeDLQEntry(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := entry.Event()
+	if got.Key != "trace-1" || got.Topic != HoBomLog || string(got.Value) != `[{"level":"INFO"}]` {
+		t.Errorf("expected original event back, got %+v", got)
+	}
+	if publisher.HeaderValue(got.Headers, publisher.HeaderEventId) != "event-1" {
+		t.Errorf("expected headers preserved, got %v", got.Headers)
+	}
+	if entry.Error != "kafka: write timeout" || time.Since(entry.FailedAt) > time.Minute {
+		t.Errorf("expected the publish error and failure time, got %q at %v", entry.Error, entry.FailedAt)
+	}
+}
+
+func TestDecodeDLQEntry_LegacyPayloads(t *testing.T) {
+	for _, raw := range []string{`{"type":"MAIL_MESSAGE"}`, `[{"level":"INFO"}]`} {
+		entry, err := DecodeDLQEntry([]byte(raw))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", raw, err)
+		}
+		if entry.Key != "" || string(entry.Payload) != raw {
+			t.Errorf("%s: expected payload-only entry, got %+v", raw, entry)
+		}
+	}
+}
+
+func TestDecodeDLQEntry_InvalidJSON(t *testing.T) {
+	if _, err := DecodeDLQEntry([]byte("not json")); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestNewDLQEntry_PayloadIsEmbeddedAsJSON(t *testing.T) {
+	data, _ := json.Marshal(NewDLQEntry(publisher.Event{Value: []byte(`{"title":"hi"}`)}))
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload, ok := decoded["payload"].(map[string]any); !ok || payload["title"] != "hi" {
+		t.Errorf("expected payload embedded as an object, got %s", data)
+	}
+}
+
+func TestDLQEntry_BinaryValueRoundTrip(t *testing.T) {
+	event := publisher.Event{Key: "k", Topic: HoBomLog, Value: []byte{0, 0, 0, 0, 1, 0x0a}}.WithContentType("application/avro")
+	data, _ := json.Marshal(NewDLQEntry(event))
+
+	entry, err := DecodeDLQEntry(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Payload != nil {
+		t.Errorf("expected binary value outside payload, got %s", entry.Payload)
+	}
+	if got := entry.Event().Value; string(got) != string(event.Value) {
+		t.Errorf("expected value % x, got % x", event.Value, got)
+	}
+}
+
+func TestDLQEntry_EditKeepsReplacedVersions(t *testing.T) {
+	entry := DLQEntry{Key: "r", Topic: HoBomMessage, Payload: json.RawMessage(`{"recipient":""}`), Reason: "recipient must not be empty"}
+	first := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	entry.Edit(json.RawMessage(`{"recipient":"user1"}`), "alice", first)
+	entry.Edit(json.RawMessage(`{"recipient":"user-1"}`), "bob", first.Add(time.Minute))
+
+	if entry.Version != 2 || entry.EditedBy != "bob" || entry.Reason != "" || string(entry.Payload) != `{"recipient":"user-1"}` {
+		t.Fatalf("unexpected current version %+v", entry)
+	}
+	if len(entry.History) != 2 {
+		t.Fatalf("expected 2 replaced versions, got %+v", entry.History)
+	}
+	if h := entry.History[0]; h.Version != 0 || h.Reason == "" || !h.EditedAt.IsZero() || string(h.Payload) != `{"recipient":""}` {
+		t.Errorf("expected the failed event as version 0, got %+v", h)
+	}
+	if h := entry.History[1]; h.Version != 1 || h.EditedBy != "alice" || !h.EditedAt.Equal(first) {
+		t.Errorf("expected alice's edit as version 1, got %+v", h)
+	}
+
+	// 저장 후 다시 읽어도 이력이 유지되어야 한다.
+	data, _ := json.Marshal(entry)
+	decoded, err := DecodeDLQEntry(data)
+	if err != nil || decoded.Version != 2 || len(decoded.History) != 2 || decoded.History[1].EditedBy != "alice" {
+		t.Errorf("expected the history to round-trip, got %+v %v", decoded, err)
+	}
+}
@@ -0,0 +1,120 @@
+package poller
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// metricInFlight is the gauge of outbox rows a poller has claimed and not yet
+// settled, published next to the counters in metrics.go.
+const metricInFlight = "inFlight"
+
+// DrainReport describes how the poll loops stopped.
+type DrainReport struct {
+	// Completed reports that every cycle finished before the drain deadline.
+	Completed bool `json:"completed"`
+	// Elapsed is how long the drain took.
+	Elapsed time.Duration `json:"elapsed"`
+	// Unfinished is the number of claimed rows per poller whose cycle was
+	// still running at the deadline. They stay IN_FLIGHT until their lease
+	// expires and another replica claims them again.
+	Unfinished map[string]int64 `json:"unfinished,omitempty"`
+}
+
+type stopKey struct{}
+
+// withStop returns a context carrying the stop signal of the poll loops.
+// Once stop is closed, a cycle finishes the rows it has claimed but fetches
+// no further pages.
+func withStop(ctx context.Context, stop <-chan struct{}) context.Context {
+	return context.WithValue(ctx, stopKey{}, stop)
+}
+
+// stopping reports whether the poll loops were told to stop, either through
+// the stop signal of ctx or because ctx itself is done.
+func stopping(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+	stop, _ := ctx.Value(stopKey{}).(<-chan struct{})
+	select {
+	case <-stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// inFlightCounter is implemented by pollers that count the rows they have
+// claimed and not yet settled.
+type inFlightCounter interface {
+	InFlight() int64
+}
+
+// inFlight counts the outbox rows a poller has claimed and not yet settled.
+type inFlight struct {
+	name string
+	n    atomic.Int64
+}
+
+func newInFlight(name string) *inFlight {
+	return &inFlight{name: name}
+}
+
+// hold counts n claimed rows until the returned release is called.
+func (f *inFlight) hold(n int) (release func()) {
+	f.add(int64(n))
+	return func() { f.add(-int64(n)) }
+}
+
+func (f *inFlight) add(n int64) {
+	f.n.Add(n)
+	metricsFor(f.name).Add(metricInFlight, n)
+}
+
+func (f *inFlight) count() int64 {
+	return f.n.Load()
+}
+
+// Drain waits for the goroutines of the Supervisor to return after the ctx
+// given to StartAllPollers was cancelled. Cycles keep publishing and settling
+// the rows they have claimed until ctx is done; the rows still claimed then
+// are reported as unfinished and the remaining publishes and outbox updates
+// are cancelled.
+func (s *Supervisor) Drain(ctx context.Context) DrainReport {
+	start := time.Now()
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	var report DrainReport
+	select {
+	case <-done:
+		report.Completed = true
+	case <-ctx.Done():
+		// Deadline 이 지나면 남은 Row 를 기록한 후, 진행 중인 발행과 Outbox 갱신을 취소한다.
+		report.Unfinished = s.inFlight()
+		s.cancelWork()
+		<-done
+	}
+	s.cancelWork()
+	report.Elapsed = time.Since(start)
+	return report
+}
+
+// inFlight returns the claimed rows of every poller that counts them and
+// still holds some.
+func (s *Supervisor) inFlight() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	unfinished := make(map[string]int64)
+	for name, l := range s.loops {
+		if c, ok := l.poller.(inFlightCounter); ok && c.InFlight() > 0 {
+			unfinished[name] = c.InFlight()
+		}
+	}
+	return unfinished
+}
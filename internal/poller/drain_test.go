@@ -0,0 +1,106 @@
+package poller
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// drainingPoller claims rows in its first cycle and holds them until release
+// is closed or its context is cancelled.
+type drainingPoller struct {
+	inFlight *inFlight
+	started  chan struct{}
+	release  chan struct{}
+	err      chan error
+}
+
+func newDrainingPoller(name string) *drainingPoller {
+	return &drainingPoller{
+		inFlight: newInFlight(name),
+		started:  make(chan struct{}),
+		release:  make(chan struct{}),
+		err:      make(chan error, 1),
+	}
+}
+
+func (p *drainingPoller) InFlight() int64 { return p.inFlight.count() }
+
+func (p *drainingPoller) Poll(ctx context.Context) PollResult {
+	defer p.inFlight.hold(3)()
+	close(p.started)
+	select {
+	case <-p.release:
+		p.err <- ctx.Err()
+	case <-ctx.Done():
+		p.err <- ctx.Err()
+	}
+	return PollResult{Processed: 3}
+}
+
+// startDraining runs p under a Supervisor, triggers its cycle and cancels
+// the poll loops once the cycle has claimed its rows.
+func startDraining(t *testing.T, p *drainingPoller) *Supervisor {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.PollInterval = time.Hour
+	cfg.MaxPollInterval = time.Hour
+	supervisor := newSupervisor(cfg)
+	supervisor.add("draining", p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	supervisor.run(ctx)
+	if err := supervisor.Trigger("draining"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-p.started
+	cancel()
+	return supervisor
+}
+
+func TestSupervisor_DrainLetsClaimedRowsFinish(t *testing.T) {
+	p := newDrainingPoller("draining-complete")
+	supervisor := startDraining(t, p)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(p.release)
+	}()
+	report := supervisor.Drain(ctx)
+
+	if err := <-p.err; err != nil {
+		t.Errorf("expected the cycle to keep its context while draining, got %v", err)
+	}
+	if !report.Completed || len(report.Unfinished) != 0 {
+		t.Errorf("expected a completed drain, got %+v", report)
+	}
+	if p.InFlight() != 0 {
+		t.Errorf("expected no rows in flight, got %d", p.InFlight())
+	}
+	if status := supervisor.Status()[0]; status.Cycles != 1 || status.State != LoopStopped {
+		t.Errorf("expected the cycle completed and the loop stopped, got %+v", status)
+	}
+}
+
+func TestSupervisor_DrainDeadlineReportsUnfinishedRows(t *testing.T) {
+	p := newDrainingPoller("draining-deadline")
+	supervisor := startDraining(t, p)
+	before := metricValue("draining-deadline", metricInFlight)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	report := supervisor.Drain(ctx)
+
+	if err := <-p.err; err == nil {
+		t.Error("expected the cycle cancelled at the deadline")
+	}
+	if report.Completed || report.Unfinished["draining"] != 3 {
+		t.Errorf("expected 3 unfinished rows, got %+v", report)
+	}
+	if got := metricValue("draining-deadline", metricInFlight); got != before-3 {
+		t.Errorf("expected the inFlight gauge released, got %d (was %d)", got, before)
+	}
+}
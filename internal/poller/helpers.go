@@ -10,11 +10,15 @@ import (
 
 // publishWithRetry publishes an event to Kafka with exponential backoff.
 // Retries up to 3 times (200ms → 400ms) before returning the final error.
+// Every retry advances the event's attempt header.
 func publishWithRetry(ctx context.Context, pub publisher.KafkaPublisher, event publisher.Event) error {
 	const maxAttempts = 3
 	delay := 200 * time.Millisecond
 	var err error
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			event = nextAttempt(event)
+		}
 		if err = pub.Publish(ctx, event); err == nil {
 			return nil
 		}
@@ -30,6 +34,94 @@ func publishWithRetry(ctx context.Context, pub publisher.KafkaPublisher, event p
 	return err
 }
 
+// publishBatchWithRetry publishes events in a single batch and retries only the
+// events that failed, with the same backoff as publishWithRetry. The returned
+// slice holds the final result of each event, in order. Every retry advances
+// the attempt header of the retried events in place, so after the call events
+// carry the attempt they were last published with.
+func publishBatchWithRetry(ctx context.Context, pub publisher.KafkaPublisher, events []publisher.Event) []error {
+	const maxAttempts = 3
+	delay := 200 * time.Millisecond
+	errs := make([]error, len(events))
+	pending := make([]int, len(events))
+	for i := range events {
+		pending[i] = i
+	}
+
+	for attempt := 1; attempt <= maxAttempts && len(pending) > 0; attempt++ {
+		batch := make([]publisher.Event, len(pending))
+		for j, i := range pending {
+			if attempt > 1 {
+				events[i] = nextAttempt(events[i])
+			}
+			batch[j] = events[i]
+		}
+
+		var failed []int
+		for j, err := range pub.PublishBatch(ctx, batch) {
+			errs[pending[j]] = err
+			if err != nil {
+				failed = append(failed, pending[j])
+			}
+		}
+		pending = failed
+
+		if attempt < maxAttempts && len(pending) > 0 {
+			select {
+			case <-ctx.Done():
+				for _, i := range pending {
+					errs[i] = ctx.Err()
+				}
+				return errs
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+	}
+	return errs
+}
+
+// publishAtomicallyWithRetry publishes events in one Kafka transaction and
+// retries the whole transaction with the same backoff as publishWithRetry.
+// Every event shares the final result, and every retry advances the attempt
+// header of all events in place.
+func publishAtomicallyWithRetry(ctx context.Context, pub publisher.AtomicPublisher, events []publisher.Event) []error {
+	const maxAttempts = 3
+	delay := 200 * time.Millisecond
+	var err error
+retry:
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			for i := range events {
+				events[i] = nextAttempt(events[i])
+			}
+		}
+		if err = pub.PublishAtomically(ctx, events); err == nil {
+			break
+		}
+		if attempt < maxAttempts {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+				break retry
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+	}
+
+	errs := make([]error, len(events))
+	for i := range errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+// nextAttempt returns a copy of event with its attempt header advanced by one.
+func nextAttempt(event publisher.Event) publisher.Event {
+	return event.WithAttempt(max(event.Attempt(), 1) + 1)
+}
+
 func structToMap(v interface{}) (map[string]interface{}, error) {
 	data, err := json.Marshal(v)
 	if err != nil {
@@ -23,6 +23,15 @@ func (m *mockPublisher) Publish(_ context.Context, _ publisher.Event) error {
 	return nil
 }
 
+// PublishBatch applies the same failUntil rule to every event in the batch.
+func (m *mockPublisher) PublishBatch(ctx context.Context, events []publisher.Event) []error {
+	errs := make([]error, len(events))
+	for i, event := range events {
+		errs[i] = m.Publish(ctx, event)
+	}
+	return errs
+}
+
 func (m *mockPublisher) Close() error { return nil }
 
 func TestPublishWithRetry_SuccessFirstAttempt(t *testing.T) {
@@ -76,3 +85,117 @@ func TestPublishWithRetry_ContextCancelledBetweenRetries(t *testing.T) {
 		t.Errorf("expected 1 call before context cancel, got %d", pub.callCount)
 	}
 }
+
+// keyedFailPublisher fails every attempt for events whose key is in failKeys.
+type keyedFailPublisher struct {
+	failKeys map[string]bool
+	attempts map[string]int
+}
+
+func (m *keyedFailPublisher) Publish(_ context.Context, event publisher.Event) error {
+	m.attempts[event.Key]++
+	if m.failKeys[event.Key] {
+		return errors.New("broker rejected message")
+	}
+	return nil
+}
+
+func (m *keyedFailPublisher) PublishBatch(ctx context.Context, events []publisher.Event) []error {
+	errs := make([]error, len(events))
+	for i, event := range events {
+		errs[i] = m.Publish(ctx, event)
+	}
+	return errs
+}
+
+func (m *keyedFailPublisher) Close() error { return nil }
+
+func TestPublishBatchWithRetry_RetriesOnlyFailedEvents(t *testing.T) {
+	pub := &keyedFailPublisher{
+		failKeys: map[string]bool{"bad": true},
+		attempts: make(map[string]int),
+	}
+	events := []publisher.Event{{Key: "ok-1"}, {Key: "bad"}, {Key: "ok-2"}}
+
+	errs := publishBatchWithRetry(context.Background(), pub, events)
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("expected successful events to have nil errors, got %v", errs)
+	}
+	if errs[1] == nil {
+		t.Error("expected failing event to report an error")
+	}
+	if pub.attempts["ok-1"] != 1 || pub.attempts["ok-2"] != 1 {
+		t.Errorf("expected successful events to be published once, got %v", pub.attempts)
+	}
+	if pub.attempts["bad"] != 3 {
+		t.Errorf("expected failing event to be attempted 3 times, got %d", pub.attempts["bad"])
+	}
+	if events[0].Attempt() != 0 || events[1].Attempt() != 3 {
+		t.Errorf("expected only the retried event's attempt to advance, got %d and %d", events[0].Attempt(), events[1].Attempt())
+	}
+}
+
+func TestPublishBatchWithRetry_ContextCancelledBetweenRetries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pub := &mockPublisher{failUntil: 99, failErr: errors.New("err")}
+	errs := publishBatchWithRetry(ctx, pub, []publisher.Event{{Key: "a"}, {Key: "b"}})
+
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("event %d: expected context.Canceled, got %v", i, err)
+		}
+	}
+	if pub.callCount != 2 {
+		t.Errorf("expected a single batch attempt (2 events), got %d calls", pub.callCount)
+	}
+}
+
+// atomicMockPublisher fails the first failUntil transactions.
+type atomicMockPublisher struct {
+	mockPublisher
+	transactions int
+}
+
+func (m *atomicMockPublisher) PublishAtomically(_ context.Context, _ []publisher.Event) error {
+	m.transactions++
+	if m.transactions <= m.failUntil {
+		return m.failErr
+	}
+	return nil
+}
+
+func TestPublishAtomicallyWithRetry_RetriesWholeTransaction(t *testing.T) {
+	pub := &atomicMockPublisher{mockPublisher: mockPublisher{failUntil: 1, failErr: errors.New("txn aborted")}}
+	events := []publisher.Event{{Key: "a"}, {Key: "b"}}
+
+	errs := publishAtomicallyWithRetry(context.Background(), pub, events)
+
+	if errs[0] != nil || errs[1] != nil {
+		t.Errorf("expected success after retry, got %v", errs)
+	}
+	if pub.transactions != 2 {
+		t.Errorf("expected 2 transactions, got %d", pub.transactions)
+	}
+	if events[0].Attempt() != 2 || events[1].Attempt() != 2 {
+		t.Errorf("expected every event at attempt 2, got %d and %d", events[0].Attempt(), events[1].Attempt())
+	}
+}
+
+func TestPublishAtomicallyWithRetry_AllEventsShareFailure(t *testing.T) {
+	txnErr := errors.New("txn aborted")
+	pub := &atomicMockPublisher{mockPublisher: mockPublisher{failUntil: 99, failErr: txnErr}}
+
+	errs := publishAtomicallyWithRetry(context.Background(), pub, []publisher.Event{{Key: "a"}, {Key: "b"}})
+
+	for i, err := range errs {
+		if !errors.Is(err, txnErr) {
+			t.Errorf("event %d: expected txn error, got %v", i, err)
+		}
+	}
+	if pub.transactions != 3 {
+		t.Errorf("expected 3 transactions, got %d", pub.transactions)
+	}
+}
@@ -0,0 +1,88 @@
+package poller
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+)
+
+// partitionLanes splits items into at most n lanes. With a nil keyFn items are
+// dealt round-robin; otherwise items sharing a key always land in the same
+// lane. Items keep their relative order within a lane, and empty lanes are
+// dropped.
+func partitionLanes[T any](items []T, n int, keyFn func(T) string) [][]T {
+	if n < 1 {
+		n = 1
+	}
+	if n > len(items) {
+		n = len(items)
+	}
+
+	lanes := make([][]T, n)
+	for i, item := range items {
+		lane := i % n
+		if keyFn != nil {
+			h := fnv.New32a()
+			h.Write([]byte(keyFn(item)))
+			lane = int(h.Sum32() % uint32(n))
+		}
+		lanes[lane] = append(lanes[lane], item)
+	}
+
+	nonEmpty := lanes[:0]
+	for _, lane := range lanes {
+		if len(lane) > 0 {
+			nonEmpty = append(nonEmpty, lane)
+		}
+	}
+	return nonEmpty
+}
+
+// runLanes processes every lane on its own goroutine and waits for all of them
+// to finish. Each lane is handed to process in chunks of at most chunkSize
+// items, in order. Once ctx is cancelled no further chunk is started; the
+// items left behind stay PENDING for a later cycle and their count is
+// returned. A panic in a lane is re-raised on the caller's goroutine once
+// every lane has stopped, so the poll loop can recover from it.
+func runLanes[T any](ctx context.Context, lanes [][]T, chunkSize int, process func(context.Context, []T)) int {
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		skipped int
+		crash   *PanicError
+	)
+	for _, lane := range lanes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Worker 의 panic 은 프로세스를 종료시키므로, 복구한 후 호출한 Goroutine 에서 다시 발생시킨다.
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					if crash == nil {
+						crash = newPanicError(r)
+					}
+					mu.Unlock()
+				}
+			}()
+			for start := 0; start < len(lane); start += chunkSize {
+				if ctx.Err() != nil {
+					mu.Lock()
+					skipped += len(lane) - start
+					mu.Unlock()
+					return
+				}
+				process(ctx, lane[start:min(start+chunkSize, len(lane))])
+			}
+		}()
+	}
+	wg.Wait()
+	if crash != nil {
+		panic(crash)
+	}
+	return skipped
+}
@@ -0,0 +1,106 @@
+package poller
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+type laneItem struct {
+	id  int
+	key string
+}
+
+func TestPartitionLanes_RoundRobin(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	lanes := partitionLanes(items, 2, nil)
+
+	if len(lanes) != 2 {
+		t.Fatalf("expected 2 lanes, got %d", len(lanes))
+	}
+	if len(lanes[0]) != 3 || len(lanes[1]) != 2 {
+		t.Errorf("expected lanes of 3 and 2 items, got %v", lanes)
+	}
+}
+
+func TestPartitionLanes_NeverMoreLanesThanItems(t *testing.T) {
+	lanes := partitionLanes([]int{1, 2}, 8, nil)
+
+	if len(lanes) != 2 {
+		t.Errorf("expected 2 lanes for 2 items, got %d", len(lanes))
+	}
+	if len(partitionLanes([]int{}, 8, nil)) != 0 {
+		t.Error("expected no lanes for no items")
+	}
+}
+
+func TestPartitionLanes_SameKeySameLaneInOrder(t *testing.T) {
+	items := []laneItem{
+		{1, "alice"}, {2, "bob"}, {3, "alice"}, {4, "carol"}, {5, "alice"}, {6, "bob"},
+	}
+
+	lanes := partitionLanes(items, 3, func(i laneItem) string { return i.key })
+
+	seen := make(map[string]int)
+	for laneIdx, lane := range lanes {
+		last := make(map[string]int)
+		for _, item := range lane {
+			if prev, ok := seen[item.key]; ok && prev != laneIdx {
+				t.Errorf("key %q spread across lanes %d and %d", item.key, prev, laneIdx)
+			}
+			seen[item.key] = laneIdx
+			if item.id < last[item.key] {
+				t.Errorf("key %q out of order in lane %d", item.key, laneIdx)
+			}
+			last[item.key] = item.id
+		}
+	}
+}
+
+func TestRunLanes_ProcessesAllItemsInChunks(t *testing.T) {
+	lanes := [][]int{{1, 2, 3}, {4, 5}}
+	var (
+		mu     sync.Mutex
+		chunks [][]int
+	)
+
+	skipped := runLanes(context.Background(), lanes, 2, func(_ context.Context, chunk []int) {
+		mu.Lock()
+		defer mu.Unlock()
+		chunks = append(chunks, append([]int(nil), chunk...))
+	})
+
+	if skipped != 0 {
+		t.Errorf("expected nothing skipped, got %d", skipped)
+	}
+	total := 0
+	for _, c := range chunks {
+		if len(c) > 2 {
+			t.Errorf("chunk exceeds chunk size: %v", c)
+		}
+		total += len(c)
+	}
+	if total != 5 {
+		t.Errorf("expected 5 items processed, got %d", total)
+	}
+}
+
+func TestRunLanes_StopsStartingChunksAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	processed := 0
+
+	skipped := runLanes(ctx, [][]int{{1, 2, 3, 4}}, 1, func(_ context.Context, chunk []int) {
+		processed += len(chunk)
+		if processed == 2 {
+			cancel()
+		}
+	})
+
+	if processed != 2 {
+		t.Errorf("expected 2 items processed before cancel, got %d", processed)
+	}
+	if skipped != 2 {
+		t.Errorf("expected 2 items skipped, got %d", skipped)
+	}
+}
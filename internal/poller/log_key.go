@@ -0,0 +1,78 @@
+package poller
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LogKeyStrategy selects which field of a log event is used as the Kafka
+// message key. Logs sharing a key are published together, in the order they
+// were fetched, and land on the same partition.
+type LogKeyStrategy string
+
+const (
+	// LogKeyByServiceType keys log batches by the originating service.
+	LogKeyByServiceType LogKeyStrategy = "SERVICE_TYPE"
+	// LogKeyByHost keys log batches by the host that served the request.
+	LogKeyByHost LogKeyStrategy = "HOST"
+	// LogKeyByTraceId keys log batches by trace ID so a single request's logs stay ordered.
+	LogKeyByTraceId LogKeyStrategy = "TRACE_ID"
+	// LogKeyByUserId keys log batches by the requesting user.
+	LogKeyByUserId LogKeyStrategy = "USER_ID"
+	// LogKeyRoundRobin publishes the whole cycle as one unkeyed batch,
+	// letting the balancer spread batches across partitions.
+	LogKeyRoundRobin LogKeyStrategy = "ROUND_ROBIN"
+)
+
+// ParseLogKeyStrategy converts a case-insensitive strategy name into a LogKeyStrategy.
+func ParseLogKeyStrategy(s string) (LogKeyStrategy, error) {
+	strategy := LogKeyStrategy(strings.ToUpper(strings.TrimSpace(s)))
+	switch strategy {
+	case LogKeyByServiceType, LogKeyByHost, LogKeyByTraceId, LogKeyByUserId, LogKeyRoundRobin:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown log key strategy: %q", s)
+	}
+}
+
+// keyFor returns the partition key for cmd. An empty key means the event has
+// no value for the selected field and is published without a key.
+func (s LogKeyStrategy) keyFor(cmd HoBomLogMessageCommand) string {
+	switch s {
+	case LogKeyByServiceType:
+		return cmd.ServiceType
+	case LogKeyByHost:
+		return cmd.Host
+	case LogKeyByTraceId:
+		return cmd.TraceId
+	case LogKeyByUserId:
+		return cmd.UserId
+	default:
+		return ""
+	}
+}
+
+// logGroup is a set of log entries published as a single Kafka message.
+type logGroup struct {
+	key     string
+	entries []logEntry
+}
+
+// groupLogEntries groups entries by their partition key. Groups are returned in
+// the order their first entry appeared, and entries keep their fetch order
+// within a group.
+func groupLogEntries(entries []logEntry, strategy LogKeyStrategy) []logGroup {
+	var groups []logGroup
+	index := make(map[string]int)
+	for _, e := range entries {
+		key := strategy.keyFor(e.cmd)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, logGroup{key: key})
+		}
+		groups[i].entries = append(groups[i].entries, e)
+	}
+	return groups
+}
@@ -0,0 +1,96 @@
+package poller
+
+import "testing"
+
+func newTestLogEntry(eventId, traceId, host string) logEntry {
+	return logEntry{
+		eventId: eventId,
+		cmd:     HoBomLogMessageCommand{TraceId: traceId, Host: host},
+	}
+}
+
+func TestGroupLogEntries_ByTraceId_PreservesOrder(t *testing.T) {
+	entries := []logEntry{
+		newTestLogEntry("e1", "trace-a", "host-1"),
+		newTestLogEntry("e2", "trace-b", "host-1"),
+		newTestLogEntry("e3", "trace-a", "host-2"),
+		newTestLogEntry("e4", "trace-b", "host-2"),
+		newTestLogEntry("e5", "trace-a", "host-1"),
+	}
+
+	groups := groupLogEntries(entries, LogKeyByTraceId)
+
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].key != "trace-a" || groups[1].key != "trace-b" {
+		t.Errorf("expected groups in first-seen order [trace-a trace-b], got [%s %s]", groups[0].key, groups[1].key)
+	}
+
+	var ids []string
+	for _, e := range groups[0].entries {
+		ids = append(ids, e.eventId)
+	}
+	if len(ids) != 3 || ids[0] != "e1" || ids[1] != "e3" || ids[2] != "e5" {
+		t.Errorf("expected trace-a entries [e1 e3 e5] in fetch order, got %v", ids)
+	}
+}
+
+func TestGroupLogEntries_ByHost(t *testing.T) {
+	entries := []logEntry{
+		newTestLogEntry("e1", "trace-a", "host-1"),
+		newTestLogEntry("e2", "trace-b", "host-1"),
+		newTestLogEntry("e3", "trace-c", "host-2"),
+	}
+
+	groups := groupLogEntries(entries, LogKeyByHost)
+
+	if len(groups) != 2 || len(groups[0].entries) != 2 || groups[0].key != "host-1" {
+		t.Errorf("expected host-1 group with 2 entries first, got %+v", groups)
+	}
+}
+
+func TestGroupLogEntries_RoundRobin_SingleUnkeyedGroup(t *testing.T) {
+	entries := []logEntry{
+		newTestLogEntry("e1", "trace-a", "host-1"),
+		newTestLogEntry("e2", "trace-b", "host-2"),
+	}
+
+	groups := groupLogEntries(entries, LogKeyRoundRobin)
+
+	if len(groups) != 1 {
+		t.Fatalf("expected 1 group, got %d", len(groups))
+	}
+	if groups[0].key != "" {
+		t.Errorf("expected empty key for round-robin, got %q", groups[0].key)
+	}
+	if len(groups[0].entries) != 2 {
+		t.Errorf("expected 2 entries, got %d", len(groups[0].entries))
+	}
+}
+
+func TestParseLogKeyStrategy(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    LogKeyStrategy
+		wantErr bool
+	}{
+		{"TRACE_ID", LogKeyByTraceId, false},
+		{" user_id ", LogKeyByUserId, false},
+		{"round_robin", LogKeyRoundRobin, false},
+		{"partition", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseLogKeyStrategy(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLogKeyStrategy(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLogKeyStrategy(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package poller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -10,146 +11,310 @@ import (
 	outboxFindPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/log/outbox/v1"
 	outboxPatchPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	publisher "github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde"
 	redisClient "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
 	"google.golang.org/grpc"
 )
 
 type logPoller struct {
 	findClient  outboxFindPb.FindHoBomLogOutboxControllerClient
-	patchClient outboxPatchPb.PatchOutboxControllerClient
+	outbox      *outbox.Client
 	publisher   publisher.KafkaPublisher
 	redisDLQ    redisClient.DLQStore
+	ackJournal  redisClient.AckJournal
+	keyStrategy LogKeyStrategy
+	pageSize    int
+	maxPerCycle int
+	producer    string
+	serializer  serde.Serializer
+	inFlight    *inFlight
 }
 
-func NewLogPoller(conn *grpc.ClientConn, publisher publisher.KafkaPublisher, redisDLQ redisClient.DLQStore) Poller {
+// logEntry is a log outbox event converted into its Kafka command form.
+type logEntry struct {
+	eventId           string
+	ref               publisher.OutboxRef
+	cmd               HoBomLogMessageCommand
+	individualPayload []byte
+}
+
+func NewLogPoller(conn *grpc.ClientConn, publisher publisher.KafkaPublisher, redisDLQ redisClient.DLQStore, ackJournal redisClient.AckJournal, cfg Config) Poller {
 	return &logPoller{
 		findClient:  outboxFindPb.NewFindHoBomLogOutboxControllerClient(conn),
-		patchClient: outboxPatchPb.NewPatchOutboxControllerClient(conn),
+		outbox:      outbox.NewClient(outboxPatchPb.NewPatchOutboxControllerClient(conn), cfg.InstanceId, cfg.LeaseDuration),
 		publisher:   publisher,
 		redisDLQ:    redisDLQ,
+		ackJournal:  ackJournal,
+		keyStrategy: cfg.LogKeyStrategy,
+		pageSize:    cfg.PageSize,
+		maxPerCycle: cfg.MaxPerCycle,
+		producer:    cfg.InstanceId,
+		serializer:  cfg.serializer(),
+		inFlight:    newInFlight(LogPollerName),
 	}
 }
 
 // gRPC 통신을 통한 for-hobom-backend 서버의 Outbox DB 를 polling 하도록 한다.
 // 해당 서버를 통과한 API 요청 및 응답에 대한 Log 들을 수집하고, hobom-internal-backend 로 적재하기 위한 데이터를 가지고 있다.
-// EventType이 `HOBOM_LOG` 이고, Outbox Status 가 `PENDING` 인 것을 가져오도록 한다.
-func (p *logPoller) Poll(ctx context.Context) {
+// EventType이 `HOBOM_LOG` 이고, Outbox Status 가 `PENDING` 인 것을 생성 순서대로 페이지 단위로 가져오도록 한다.
+func (p *logPoller) Poll(ctx context.Context) PollResult {
+	return walkPages(ctx, p.pageSize, p.maxPerCycle, p.fetchPage)
+}
+
+// InFlight returns the number of log outbox rows claimed by the running cycle
+// and not yet settled.
+func (p *logPoller) InFlight() int64 {
+	return p.inFlight.count()
+}
+
+// fetchPage fetches one page of PENDING log outbox rows, oldest first, and
+// processes it before returning the cursor of the next page.
+func (p *logPoller) fetchPage(ctx context.Context, limit int, cursor string) (int, int, string, error) {
 	req := &outboxFindPb.Request{
 		EventType: EventTypeHoBomLog,
 		Status:    OutboxPending,
+		Limit:     int32(limit),
+		Cursor:    cursor,
 	}
 
 	res, err := p.findClient.FindLogOutboxByEventTypeAndStatusUseCase(ctx, req)
 	if err != nil {
 		slog.Error("failed to fetch log outbox", "err", err)
-		return
+		return 0, 0, "", err
 	}
 
-	type logEntry struct {
-		eventId           string
-		cmd               HoBomLogMessageCommand
-		individualPayload []byte
+	// 이미 발행되었지만 SENT 반영이 되지 않은 Row 들은 다시 발행하지 않도록 한다.
+	// Redis 장애로 확인할 수 없다면, 중복 발행을 감수하고 모든 Row 를 처리하도록 한다.
+	items, err := skipUnacked(ctx, p.ackJournal, res.Items)
+	if err != nil {
+		slog.Warn("failed to check log outbox acknowledgements, processing every row", "err", err)
+		items = res.Items
+	}
+
+	// 다른 인스턴스와의 중복 발행을 막기 위해, 처리할 Row 들을 먼저 선점하도록 한다.
+	items, err = claimRows(ctx, p.outbox, items)
+	if err != nil {
+		slog.Error("failed to claim log outbox", "err", err)
+		return len(res.Items), 0, "", err
 	}
 
-	var entries []logEntry
-	for _, item := range res.Items {
-		payloadMap, err := structToMap(item.Payload)
+	defer p.inFlight.hold(len(items))()
+	p.processItems(ctx, items)
+	return len(res.Items), len(items), res.NextCursor, nil
+}
+
+// processItems groups a page of log outbox rows by partition key, publishes
+// the groups and settles every entry with one batched SENT and one batched
+// FAILED patch call.
+func (p *logPoller) processItems(ctx context.Context, items []*outboxFindPb.QueryResult) {
+	var (
+		entries  []logEntry
+		sent     []string
+		failures []outbox.Failure
+	)
+	// 페이지 처리가 끝나면, 결과를 한 번에 Outbox 에 반영하도록 한다.
+	defer func() {
+		p.markAsSent(ctx, sent)
+		p.markAsFailed(ctx, failures)
+	}()
+
+	for _, item := range items {
+		// 한 Row 에서 발생한 panic 은 해당 Event 만 FAILED 로 처리하고, 나머지 Row 는 계속 처리하도록 한다.
+		entry, err := recoverItem(LogPollerName, func() (logEntry, error) {
+			return p.prepare(ctx, item)
+		})
 		if err != nil {
-			p.markAsFailed(ctx, item.EventId, "failed to convert payload to map")
+			failures = append(failures, outbox.Failure{EventId: item.GetEventId(), Reason: err.Error()})
 			continue
 		}
+		entries = append(entries, entry)
+	}
+
+	// Event를 발행할 Commands (Log)의 길이가 0 일 경우, 아무런 동작도
+	// 수행하지 않도록 한다.
+	if len(entries) == 0 {
+		return
+	}
 
-		path := item.Payload.Path
-		cmd := HoBomLogMessageCommand{
-			ServiceType: item.Payload.ServiceType,
-			Level:       item.Payload.Level,
-			TraceId:     item.Payload.TraceId,
-			Message:     item.Payload.Message,
-			HttpMethod:  item.Payload.Method,
-			Path:        &path,
-			StatusCode:  int(item.Payload.StatusCode),
-			Host:        item.Payload.Host,
-			UserId:      item.Payload.UserId,
-			Payload:     payloadMap,
+	// 같은 Key를 가진 Log 들은 하나의 배치로 묶어 같은 파티션에 순서대로 적재되도록 한다.
+	// 모든 그룹은 한 번의 PublishBatch 호출로 발행한다.
+	var (
+		groups []logGroup
+		events []publisher.Event
+	)
+	for _, group := range groupLogEntries(entries, p.keyStrategy) {
+		commands := make(HoBomLogBatch, len(group.entries))
+		refs := make([]publisher.OutboxRef, len(group.entries))
+		for i, e := range group.entries {
+			commands[i] = e.cmd
+			refs[i] = e.ref
 		}
 
-		// 각 이벤트를 단일 원소 배열로 직렬화한다.
-		// DLQ retry 시 컨슈머가 배치 발행과 동일한 포맷을 수신하도록 보장한다.
-		individualPayload, err := json.Marshal([]HoBomLogMessageCommand{cmd})
+		value, err := p.serializer.Serialize(ctx, HoBomLog, commands)
 		if err != nil {
-			p.markAsFailed(ctx, item.EventId, fmt.Sprintf("marshal error: %v", err))
+			slog.Error("failed to encode log batch", "key", group.key, "err", err)
+			for _, e := range group.entries {
+				failures = append(failures, outbox.Failure{EventId: e.eventId, Reason: fmt.Sprintf("encode error: %v", err)})
+			}
 			continue
 		}
 
-		entries = append(entries, logEntry{
-			eventId:           item.EventId,
-			cmd:               cmd,
-			individualPayload: individualPayload,
-		})
+		// 배치에 포함된 모든 Event ID 를 순서대로 Header 에 담아, 컨슈머가 중복을 제거할 수 있도록 한다.
+		now := time.Now()
+		groups = append(groups, group)
+		events = append(events, publisher.Event{
+			Key:       group.key,
+			Value:     value,
+			Headers:   publisher.OutboxHeaders(EventTypeHoBomLog, p.producer, now, refs...),
+			Topic:     HoBomLog,
+			Timestamp: now,
+		}.WithContentType(p.serializer.ContentType()))
 	}
 
-	// Event를 발행할 Commands (Log)의 길이가 0 일 경우, 아무런 동작도
-	// 수행하지 않도록 한다.
-	if len(entries) == 0 {
+	if len(events) == 0 {
 		return
 	}
 
-	commands := make([]HoBomLogMessageCommand, len(entries))
-	for i, e := range entries {
-		commands[i] = e.cmd
+	// 트랜잭션을 지원하는 Publisher 라면, 모든 Log 배치를 하나의 트랜잭션으로 발행하도록 한다.
+	var results []error
+	if atomic, ok := p.publisher.(publisher.AtomicPublisher); ok {
+		results = publishAtomicallyWithRetry(ctx, atomic, events)
+	} else {
+		results = publishBatchWithRetry(ctx, p.publisher, events)
 	}
 
-	jsonArray, err := json.Marshal(commands)
-	if err != nil {
-		slog.Error("failed to marshal log batch", "err", err)
-		for _, e := range entries {
-			p.markAsFailed(ctx, e.eventId, fmt.Sprintf("marshal error: %v", err))
+	for i, err := range results {
+		group := groups[i]
+		// Kafka Event발행에 실패했을 경우, Outbox 데이터를 Fail 로 업데이트 하도록 한다.
+		// 그 후, Redis에 DLQ Event를 저장하도록 한다.
+		if err != nil {
+			slog.Error("kafka publish failed for log batch", "key", group.key, "count", len(group.entries), "err", err)
+			for _, e := range group.entries {
+				failures = append(failures, outbox.Failure{EventId: e.eventId, Reason: fmt.Sprintf("publish error: %v", err)})
+				saveDLQ(p.redisDLQ, ctx, HoBomLogDLQPrefix, e.eventId, p.entryEvent(events[i], e), err)
+			}
+			continue
+		}
+
+		// Mark as SENT only after successful publish
+		for _, e := range group.entries {
+			sent = append(sent, e.eventId)
 		}
-		return
 	}
+}
 
-	// 파티션 분산을 위해 타임스탬프 기반 키를 사용한다.
-	err = publishWithRetry(ctx, p.publisher, publisher.Event{
-		Key:       fmt.Sprintf("hobom-log-%d", time.Now().UnixNano()),
-		Value:     jsonArray,
-		Topic:     HoBomLog,
-		Timestamp: time.Now(),
-	})
-	// Kafka Event발행에 실패했을 경우, gRPC를 통해 Outbox 데이터를 Fail 로 업데이트 하도록 한다.
-	// 그 후, Redis에 DLQ Event를 저장하도록 한다.
+// prepare converts a log outbox row into its command, validates it and
+// encodes it as a single-entry batch. Rows rejected by validation are saved
+// to the DLQ; the returned error is the reason the row is marked FAILED.
+func (p *logPoller) prepare(ctx context.Context, item *outboxFindPb.QueryResult) (logEntry, error) {
+	ref := publisher.OutboxRef{
+		EventId:   item.EventId,
+		Version:   item.Version,
+		CreatedAt: item.CreatedAt,
+	}
+	// Payload 가 없는 Row 는 발행하지 않고, 사유와 함께 별도의 DLQ 로 보내도록 한다.
+	if item.Payload == nil {
+		err := missingPayload(EventTypeHoBomLog)
+		p.rejectInvalid(ctx, ref, nil, err)
+		return logEntry{}, err
+	}
+
+	payloadMap, err := structToMap(item.Payload)
 	if err != nil {
-		slog.Error("kafka publish failed for log batch", "count", len(entries), "err", err)
-		for _, e := range entries {
-			p.markAsFailed(ctx, e.eventId, fmt.Sprintf("publish error: %v", err))
-			saveDLQ(p.redisDLQ, ctx, HoBomLogDLQPrefix, e.eventId, e.individualPayload)
-		}
-		return
+		return logEntry{}, errors.New("failed to convert payload to map")
 	}
 
-	// Mark as SENT only after successful publish
-	for _, e := range entries {
-		p.markAsSent(ctx, e.eventId)
+	path := item.Payload.Path
+	cmd := HoBomLogMessageCommand{
+		ServiceType: item.Payload.ServiceType,
+		Level:       item.Payload.Level,
+		TraceId:     item.Payload.TraceId,
+		Message:     item.Payload.Message,
+		HttpMethod:  item.Payload.Method,
+		Path:        &path,
+		StatusCode:  int(item.Payload.StatusCode),
+		Host:        item.Payload.Host,
+		UserId:      item.Payload.UserId,
+		Payload:     payloadMap,
 	}
+	if err := cmd.Validate(); err != nil {
+		p.rejectInvalid(ctx, ref, &cmd, err)
+		return logEntry{}, err
+	}
+
+	// 각 이벤트를 단일 원소 배열로 직렬화한다.
+	// DLQ retry 시 컨슈머가 배치 발행과 동일한 포맷을 수신하도록 보장한다.
+	individualPayload, err := p.serializer.Serialize(ctx, HoBomLog, HoBomLogBatch{cmd})
+	if err != nil {
+		return logEntry{}, fmt.Errorf("encode error: %w", err)
+	}
+
+	return logEntry{
+		eventId:           item.EventId,
+		ref:               ref,
+		cmd:               cmd,
+		individualPayload: individualPayload,
+	}, nil
+}
+
+// entryEvent returns the single-entry event stored in the DLQ for an entry of
+// the failed batch, keeping the batch's key, original timestamp, attempt and
+// encoding.
+func (p *logPoller) entryEvent(batch publisher.Event, e logEntry) publisher.Event {
+	return publisher.Event{
+		Key:       batch.Key,
+		Value:     e.individualPayload,
+		Headers:   publisher.OutboxHeaders(EventTypeHoBomLog, p.producer, batch.Timestamp, e.ref),
+		Topic:     batch.Topic,
+		Timestamp: batch.Timestamp,
+	}.WithAttempt(batch.Attempt()).WithContentType(p.serializer.ContentType())
+}
+
+// rejectInvalid saves a log rejected by validation to the DLQ, as a
+// single-entry JSON batch like the entries of failed batches. cmd is nil if
+// the row has no payload.
+func (p *logPoller) rejectInvalid(ctx context.Context, ref publisher.OutboxRef, cmd *HoBomLogMessageCommand, cause error) {
+	slog.Warn("invalid log outbox event", "eventId", ref.EventId, "err", cause)
+
+	var (
+		key   string
+		value = []byte("null")
+	)
+	if cmd != nil {
+		key = p.keyStrategy.keyFor(*cmd)
+		value, _ = json.Marshal(HoBomLogBatch{*cmd})
+	}
+	now := time.Now()
+	event := publisher.Event{
+		Key:       key,
+		Value:     value,
+		Headers:   publisher.OutboxHeaders(EventTypeHoBomLog, p.producer, now, ref),
+		Topic:     HoBomLog,
+		Timestamp: now,
+	}.WithContentType(serde.JSONSerializer{}.ContentType())
+	saveInvalid(p.redisDLQ, ctx, HoBomInvalidLogDLQPrefix, ref.EventId, event, cause)
 }
 
 // gRPC 통신을 통해, for-hobom-backend 서버에 Outbox 데이터 업데이트를 위한 통신을 수행하도록 한다.
-// Outbox DB 에 `SENT` 상태로 업데이트를 한다.
-func (p *logPoller) markAsSent(ctx context.Context, eventId string) {
-	slog.Info("marking log outbox as SENT", "eventId", eventId)
-	if _, err := p.patchClient.PatchOutboxMarkAsSentUseCase(ctx, &outboxPatchPb.MarkRequest{
-		EventId: eventId,
-	}); err != nil {
-		slog.Error("failed to mark log outbox as SENT", "eventId", eventId, "err", err)
+// Outbox DB 에 `SENT` 상태로 일괄 업데이트를 한다.
+func (p *logPoller) markAsSent(ctx context.Context, eventIds []string) {
+	if len(eventIds) == 0 {
+		return
+	}
+	slog.Info("marking log outbox as SENT", "count", len(eventIds))
+	if failed, err := acknowledgeSent(ctx, p.outbox, p.ackJournal, eventIds); err != nil {
+		slog.Error("failed to mark log outbox as SENT, leaving it to the reconciler", "eventIds", failed, "err", err)
 	}
 }
 
 // gRPC 통신을 통해, for-hobom-backend 서버에 Outbox 데이터 업데이트를 위한 통신을 수행하도록 한다.
-// Outbox DB 에 `FAILED` 상태로 업데이트를 한다.
-func (p *logPoller) markAsFailed(ctx context.Context, eventId, reason string) {
-	if _, err := p.patchClient.PatchOutboxMarkAsFailedUseCase(ctx, &outboxPatchPb.MarkFailedRequest{
-		EventId:      eventId,
-		ErrorMessage: reason,
-	}); err != nil {
-		slog.Error("failed to mark log outbox as FAILED", "eventId", eventId, "err", err)
+// Outbox DB 에 `FAILED` 상태로 일괄 업데이트를 한다.
+func (p *logPoller) markAsFailed(ctx context.Context, failures []outbox.Failure) {
+	if len(failures) == 0 {
+		return
+	}
+	if failed, err := p.outbox.MarkFailed(ctx, failures); err != nil {
+		slog.Error("failed to mark log outbox as FAILED", "eventIds", failed, "err", err)
 	}
 }
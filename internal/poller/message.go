@@ -1,6 +1,12 @@
 package poller
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	hobomkafkapb "github.com/HoBom-s/hobom-event-processor/infra/kafka/schema/v1"
+	"google.golang.org/protobuf/proto"
+)
 
 type DeliverHoBomMessageCommand struct {
 	Type      string    `json:"type"`
@@ -23,3 +29,97 @@ type HoBomLogMessageCommand struct {
 	UserId      string                 `json:"userId"`
 	Payload     map[string]interface{} `json:"payload,omitempty"`
 }
+
+// HoBomLogBatch is the value of a hobom.logs event. As JSON it is a plain
+// array of commands.
+type HoBomLogBatch []HoBomLogMessageCommand
+
+func (c DeliverHoBomMessageCommand) ToProto() proto.Message {
+	return &hobomkafkapb.DeliverHoBomMessageCommand{
+		Type:      c.Type,
+		Title:     c.Title,
+		Body:      c.Body,
+		Recipient: c.Recipient,
+		SenderId:  c.SenderId,
+		SentAt:    c.SentAt.Format(time.RFC3339Nano),
+	}
+}
+
+func (c DeliverHoBomMessageCommand) AvroSchema() string {
+	return hobomkafkapb.DeliverHoBomMessageCommandAvro
+}
+
+func (c DeliverHoBomMessageCommand) ToAvro() any {
+	return map[string]any{
+		"type":      c.Type,
+		"title":     c.Title,
+		"body":      c.Body,
+		"recipient": c.Recipient,
+		"senderId":  nullableString(c.SenderId),
+		"sentAt":    c.SentAt.Format(time.RFC3339Nano),
+	}
+}
+
+func (b HoBomLogBatch) ToProto() proto.Message {
+	logs := make([]*hobomkafkapb.HoBomLogMessageCommand, len(b))
+	for i, c := range b {
+		logs[i] = &hobomkafkapb.HoBomLogMessageCommand{
+			ServiceType: c.ServiceType,
+			Level:       c.Level,
+			TraceId:     c.TraceId,
+			Message:     c.Message,
+			HttpMethod:  c.HttpMethod,
+			Path:        c.Path,
+			StatusCode:  int32(c.StatusCode),
+			Host:        c.Host,
+			UserId:      c.UserId,
+			PayloadJson: c.payloadJson(),
+		}
+	}
+	return &hobomkafkapb.HoBomLogBatch{Logs: logs}
+}
+
+func (b HoBomLogBatch) AvroSchema() string {
+	return hobomkafkapb.HoBomLogBatchAvro
+}
+
+func (b HoBomLogBatch) ToAvro() any {
+	logs := make([]any, len(b))
+	for i, c := range b {
+		logs[i] = map[string]any{
+			"serviceType": c.ServiceType,
+			"level":       c.Level,
+			"traceId":     c.TraceId,
+			"message":     c.Message,
+			"httpMethod":  c.HttpMethod,
+			"path":        nullableString(c.Path),
+			"statusCode":  c.StatusCode,
+			"host":        c.Host,
+			"userId":      c.UserId,
+			"payloadJson": c.payloadJson(),
+		}
+	}
+	return map[string]any{"logs": logs}
+}
+
+// payloadJson returns the payload as a JSON object, or "" if there is none.
+// Protobuf and Avro have no schemaless map type, so the payload is carried
+// as a string.
+func (c HoBomLogMessageCommand) payloadJson() string {
+	if c.Payload == nil {
+		return ""
+	}
+	data, err := json.Marshal(c.Payload)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// nullableString returns s as the value of a ["null", "string"] Avro union.
+func nullableString(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
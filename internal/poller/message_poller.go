@@ -9,49 +9,236 @@ import (
 
 	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	publisher "github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde"
 	redisClient "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
 	"google.golang.org/grpc"
 )
 
 type messagePoller struct {
-	findClient  outboxPb.FindHoBomMessageOutboxControllerClient
-	patchClient outboxPb.PatchOutboxControllerClient
-	publisher   publisher.KafkaPublisher
-	redisDLQ    redisClient.DLQStore
+	findClient       outboxPb.FindHoBomMessageOutboxControllerClient
+	outbox           *outbox.Client
+	publisher        publisher.KafkaPublisher
+	redisDLQ         redisClient.DLQStore
+	ackJournal       redisClient.AckJournal
+	concurrency      int
+	batchSize        int
+	orderByRecipient bool
+	pageSize         int
+	maxPerCycle      int
+	producer         string
+	serializer       serde.Serializer
+	inFlight         *inFlight
 }
 
-func NewMessagePoller(conn *grpc.ClientConn, publisher publisher.KafkaPublisher, redisDLQ redisClient.DLQStore) Poller {
+// pendingMessage is a message outbox event ready to be published.
+type pendingMessage struct {
+	eventId   string
+	recipient string
+	event     publisher.Event
+}
+
+func NewMessagePoller(conn *grpc.ClientConn, publisher publisher.KafkaPublisher, redisDLQ redisClient.DLQStore, ackJournal redisClient.AckJournal, cfg Config) Poller {
 	return &messagePoller{
-		findClient:  outboxPb.NewFindHoBomMessageOutboxControllerClient(conn),
-		patchClient: outboxPb.NewPatchOutboxControllerClient(conn),
-		publisher:   publisher,
-		redisDLQ:    redisDLQ,
+		findClient:       outboxPb.NewFindHoBomMessageOutboxControllerClient(conn),
+		outbox:           outbox.NewClient(outboxPb.NewPatchOutboxControllerClient(conn), cfg.InstanceId, cfg.LeaseDuration),
+		publisher:        publisher,
+		redisDLQ:         redisDLQ,
+		ackJournal:       ackJournal,
+		concurrency:      cfg.Concurrency,
+		batchSize:        cfg.BatchSize,
+		orderByRecipient: cfg.OrderByRecipient,
+		pageSize:         cfg.PageSize,
+		maxPerCycle:      cfg.MaxPerCycle,
+		producer:         cfg.InstanceId,
+		serializer:       cfg.serializer(),
+		inFlight:         newInFlight(MessagePollerName),
 	}
 }
 
 // gRPC 통신을 통해 for-hobom-backend 서버의 Outbox DB 를 polling 하도록 한다.
 // Payload에는 다른 사용자에게 Message를 전송하기 위한 데이터를 가지고 있다.
-// Outbox Status 가 `PENDING` 인 것을 가져오도록 한다.
-func (p *messagePoller) Poll(ctx context.Context) {
+// Outbox Status 가 `PENDING` 인 것을 생성 순서대로 페이지 단위로 가져오도록 한다.
+func (p *messagePoller) Poll(ctx context.Context) PollResult {
+	return walkPages(ctx, p.pageSize, p.maxPerCycle, p.fetchPage)
+}
+
+// InFlight returns the number of message outbox rows claimed by the running
+// cycle and not yet settled.
+func (p *messagePoller) InFlight() int64 {
+	return p.inFlight.count()
+}
+
+// fetchPage fetches one page of PENDING message outbox rows, oldest first, and
+// processes it before returning the cursor of the next page.
+func (p *messagePoller) fetchPage(ctx context.Context, limit int, cursor string) (int, int, string, error) {
 	req := &outboxPb.Request{
 		EventType: EventTypeHoBomMessage,
 		Status:    OutboxPending,
+		Limit:     int32(limit),
+		Cursor:    cursor,
 	}
 
 	res, err := p.findClient.FindOutboxByEventTypeAndStatusUseCase(ctx, req)
 	if err != nil {
 		slog.Error("failed to fetch message outbox", "err", err)
+		return 0, 0, "", err
+	}
+
+	// 이미 발행되었지만 SENT 반영이 되지 않은 Row 들은 다시 발행하지 않도록 한다.
+	// Redis 장애로 확인할 수 없다면, 중복 발행을 감수하고 모든 Row 를 처리하도록 한다.
+	items, err := skipUnacked(ctx, p.ackJournal, res.Items)
+	if err != nil {
+		slog.Warn("failed to check message outbox acknowledgements, processing every row", "err", err)
+		items = res.Items
+	}
+
+	// 다른 인스턴스와의 중복 발행을 막기 위해, 처리할 Row 들을 먼저 선점하도록 한다.
+	items, err = claimRows(ctx, p.outbox, items)
+	if err != nil {
+		slog.Error("failed to claim message outbox", "err", err)
+		return len(res.Items), 0, "", err
+	}
+
+	defer p.inFlight.hold(len(items))()
+	p.processItems(ctx, items)
+	return len(res.Items), len(items), res.NextCursor, nil
+}
+
+// processItems publishes a page of message outbox rows and settles each one.
+func (p *messagePoller) processItems(ctx context.Context, items []*outboxPb.QueryResult) {
+	var (
+		messages []pendingMessage
+		failures []outbox.Failure
+	)
+	for _, item := range items {
+		// 한 Row 에서 발생한 panic 은 해당 Event 만 FAILED 로 처리하고, 나머지 Row 는 계속 처리하도록 한다.
+		m, err := recoverItem(MessagePollerName, func() (pendingMessage, error) {
+			return p.prepare(ctx, item)
+		})
+		if err != nil {
+			failures = append(failures, outbox.Failure{EventId: item.GetEventId(), Reason: err.Error()})
+			continue
+		}
+		messages = append(messages, m)
+	}
+
+	p.markAsFailed(ctx, failures)
+	if len(messages) == 0 {
 		return
 	}
 
-	for _, item := range res.Items {
-		p.handleMessage(ctx, item)
+	// Worker 별로 Message를 나누어 병렬로 발행 및 Outbox 상태 업데이트를 수행하도록 한다.
+	// 같은 수신자의 Message는 같은 Worker에서 순서대로 처리되도록 한다.
+	var keyFn func(pendingMessage) string
+	if p.orderByRecipient {
+		keyFn = func(m pendingMessage) string { return m.recipient }
+	}
+	lanes := partitionLanes(messages, p.concurrency, keyFn)
+	if skipped := runLanes(ctx, lanes, p.batchSize, p.publishChunk); skipped > 0 {
+		slog.Warn("message poll cycle cancelled, leaving messages pending", "skipped", skipped)
+	}
+}
+
+// prepare validates and encodes a message outbox row. Rows rejected by
+// validation are saved to the DLQ; the returned error is the reason the row
+// is marked FAILED.
+func (p *messagePoller) prepare(ctx context.Context, item *outboxPb.QueryResult) (pendingMessage, error) {
+	ref := publisher.OutboxRef{
+		EventId:   item.EventId,
+		Version:   item.Version,
+		CreatedAt: item.CreatedAt,
+	}
+
+	// 발행 전에 Payload 를 검증하고, 잘못된 Event 는 사유와 함께 별도의 DLQ 로 보내도록 한다.
+	cmd, err := validMessageCommand(item)
+	if err != nil {
+		p.rejectInvalid(ctx, ref, cmd, err)
+		return pendingMessage{}, err
+	}
+
+	value, err := p.serializer.Serialize(ctx, HoBomMessage, *cmd)
+	if err != nil {
+		slog.Error("failed to encode message payload", "eventId", item.EventId, "err", err)
+		return pendingMessage{}, fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	now := time.Now()
+	return pendingMessage{
+		eventId:   item.EventId,
+		recipient: item.Payload.Recipient,
+		event: publisher.Event{
+			Key:       item.EventId,
+			Value:     value,
+			Headers:   publisher.OutboxHeaders(EventTypeHoBomMessage, p.producer, now, ref),
+			Topic:     HoBomMessage,
+			Timestamp: now,
+		}.WithContentType(p.serializer.ContentType()),
+	}, nil
+}
+
+// publishChunk publishes a chunk of messages in one batch, then marks the
+// published ones SENT and the rest FAILED, with their payloads saved to the
+// DLQ, using one batched patch call each.
+func (p *messagePoller) publishChunk(ctx context.Context, chunk []pendingMessage) {
+	events := make([]publisher.Event, len(chunk))
+	for i, m := range chunk {
+		events[i] = m.event
+	}
+
+	var (
+		sent     []string
+		failures []outbox.Failure
+	)
+	for i, err := range publishBatchWithRetry(ctx, p.publisher, events) {
+		m := chunk[i]
+		if err != nil {
+			slog.Error("kafka publish failed", "eventId", m.eventId, "err", err)
+			failures = append(failures, outbox.Failure{
+				EventId: m.eventId,
+				Reason:  fmt.Sprintf("kafka publish failed: %v", err),
+			})
+			saveDLQ(p.redisDLQ, ctx, HoBomTodayMenuDLQPrefix, m.eventId, events[i], err)
+			continue
+		}
+		sent = append(sent, m.eventId)
+	}
+
+	p.markAsSent(ctx, sent)
+	p.markAsFailed(ctx, failures)
+}
+
+// validMessageCommand builds the command of a message outbox row and
+// validates it. The command is nil if the row has no payload.
+func validMessageCommand(item *outboxPb.QueryResult) (*DeliverHoBomMessageCommand, error) {
+	if item.Payload == nil {
+		return nil, missingPayload(EventTypeHoBomMessage)
 	}
+	cmd := buildMessageCommand(item)
+	return &cmd, cmd.Validate()
 }
 
-func (p *messagePoller) handleMessage(ctx context.Context, item *outboxPb.QueryResult) {
+// rejectInvalid saves a message rejected by validation to the DLQ. The
+// command is kept as JSON so it can be inspected and fixed; it is null if the
+// row has no payload.
+func (p *messagePoller) rejectInvalid(ctx context.Context, ref publisher.OutboxRef, cmd *DeliverHoBomMessageCommand, cause error) {
+	slog.Warn("invalid message outbox event", "eventId", ref.EventId, "err", cause)
+
+	value, _ := json.Marshal(cmd)
+	now := time.Now()
+	event := publisher.Event{
+		Key:       ref.EventId,
+		Value:     value,
+		Headers:   publisher.OutboxHeaders(EventTypeHoBomMessage, p.producer, now, ref),
+		Topic:     HoBomMessage,
+		Timestamp: now,
+	}.WithContentType(serde.JSONSerializer{}.ContentType())
+	saveInvalid(p.redisDLQ, ctx, HoBomInvalidMessageDLQPrefix, ref.EventId, event, cause)
+}
+
+func buildMessageCommand(item *outboxPb.QueryResult) DeliverHoBomMessageCommand {
 	senderId := item.Payload.SenderId
-	cmd := DeliverHoBomMessageCommand{
+	return DeliverHoBomMessageCommand{
 		Type:      Mail,
 		Title:     item.Payload.Title,
 		Body:      item.Payload.Body,
@@ -59,55 +246,27 @@ func (p *messagePoller) handleMessage(ctx context.Context, item *outboxPb.QueryR
 		SenderId:  &senderId,
 		SentAt:    time.Now(),
 	}
-	p.publishAndMark(ctx, item.EventId, cmd, HoBomMessage)
 }
 
-func (p *messagePoller) publishAndMark(
-	ctx context.Context,
-	eventId string,
-	cmd DeliverHoBomMessageCommand,
-	topic string,
-) {
-	jsonValue, err := json.Marshal(cmd)
-	if err != nil {
-		slog.Error("failed to marshal message payload", "eventId", eventId, "err", err)
-		p.markAsFailed(ctx, eventId, fmt.Sprintf("failed to marshal payload: %v", err))
+// gRPC 통신을 통해, for-hobom-backend 서버에 Outbox 데이터 업데이트를 위한 통신을 수행하도록 한다.
+// Outbox DB 에 `SENT` 상태로 일괄 업데이트를 한다.
+func (p *messagePoller) markAsSent(ctx context.Context, eventIds []string) {
+	if len(eventIds) == 0 {
 		return
 	}
-
-	if err = publishWithRetry(ctx, p.publisher, publisher.Event{
-		Key:       eventId,
-		Value:     jsonValue,
-		Topic:     topic,
-		Timestamp: time.Now(),
-	}); err != nil {
-		slog.Error("kafka publish failed", "eventId", eventId, "err", err)
-		p.markAsFailed(ctx, eventId, fmt.Sprintf("kafka publish failed: %v", err))
-		saveDLQ(p.redisDLQ, ctx, HoBomTodayMenuDLQPrefix, eventId, jsonValue)
-		return
+	slog.Info("marking message outbox as SENT", "count", len(eventIds))
+	if failed, err := acknowledgeSent(ctx, p.outbox, p.ackJournal, eventIds); err != nil {
+		slog.Error("failed to mark message outbox as SENT, leaving it to the reconciler", "eventIds", failed, "err", err)
 	}
-
-	p.markAsSent(ctx, eventId)
 }
 
 // gRPC 통신을 통해, for-hobom-backend 서버에 Outbox 데이터 업데이트를 위한 통신을 수행하도록 한다.
-// Outbox DB 에 `SENT` 상태로 업데이트를 한다.
-func (p *messagePoller) markAsSent(ctx context.Context, eventId string) {
-	slog.Info("marking message outbox as SENT", "eventId", eventId)
-	if _, err := p.patchClient.PatchOutboxMarkAsSentUseCase(ctx, &outboxPb.MarkRequest{
-		EventId: eventId,
-	}); err != nil {
-		slog.Error("failed to mark message outbox as SENT", "eventId", eventId, "err", err)
+// Outbox DB 에 `FAILED` 상태로 일괄 업데이트를 한다.
+func (p *messagePoller) markAsFailed(ctx context.Context, failures []outbox.Failure) {
+	if len(failures) == 0 {
+		return
 	}
-}
-
-// gRPC 통신을 통해, for-hobom-backend 서버에 Outbox 데이터 업데이트를 위한 통신을 수행하도록 한다.
-// Outbox DB 에 `FAILED` 상태로 업데이트를 한다.
-func (p *messagePoller) markAsFailed(ctx context.Context, eventId, reason string) {
-	if _, err := p.patchClient.PatchOutboxMarkAsFailedUseCase(ctx, &outboxPb.MarkFailedRequest{
-		EventId:      eventId,
-		ErrorMessage: reason,
-	}); err != nil {
-		slog.Error("failed to mark message outbox as FAILED", "eventId", eventId, "err", err)
+	if failed, err := p.outbox.MarkFailed(ctx, failures); err != nil {
+		slog.Error("failed to mark message outbox as FAILED", "eventIds", failed, "err", err)
 	}
 }
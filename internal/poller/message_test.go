@@ -0,0 +1,97 @@
+package poller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	outboxLogPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/log/outbox/v1"
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
+	hobomkafkapb "github.com/HoBom-s/hobom-event-processor/infra/kafka/schema/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde/registrytest"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox/outboxtest"
+)
+
+func TestHoBomLogBatch_JSONMatchesPlainArray(t *testing.T) {
+	path := "/api"
+	commands := []HoBomLogMessageCommand{{TraceId: "trace-1", Path: &path, Payload: map[string]any{"a": 1.0}}}
+
+	plain, _ := json.Marshal(commands)
+	batch, _ := json.Marshal(HoBomLogBatch(commands))
+	if string(plain) != string(batch) {
+		t.Errorf("expected %s, got %s", plain, batch)
+	}
+}
+
+func TestMessagePoller_PublishesProtobuf(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("event-1", &outboxPb.MessagePayload{Title: "title", Recipient: "user-1", SenderId: "user-2"})
+
+	registry := serde.NewRegistryClient(registrytest.NewRegistry().Serve(t), nil)
+	cfg := DefaultConfig()
+	cfg.Serializer = serde.NewProtobufSerializer(registry, hobomkafkapb.ProtoSources())
+	pub := &recordingPublisher{}
+	NewMessagePoller(backend.Dial(t), pub, nil, newMemoryAckJournal(), cfg).Poll(context.Background())
+
+	if len(pub.events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(pub.events))
+	}
+	event := pub.events[0]
+	if ct := publisher.HeaderValue(event.Headers, publisher.HeaderContentType); ct != "application/x-protobuf" {
+		t.Errorf("expected protobuf content-type, got %q", ct)
+	}
+
+	var cmd hobomkafkapb.DeliverHoBomMessageCommand
+	if _, err := serde.DeserializeProtobuf(event.Value, &cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cmd.Title != "title" || cmd.Recipient != "user-1" || cmd.GetSenderId() != "user-2" {
+		t.Errorf("unexpected command: %v", &cmd)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, cmd.SentAt); err != nil {
+		t.Errorf("expected RFC 3339 sentAt, got %q", cmd.SentAt)
+	}
+}
+
+func TestLogPoller_PublishesAvro(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddLog("log-1", &outboxLogPb.HoBomLogPayload{ServiceType: "api", Level: "INFO", TraceId: "trace-1", Path: "/api", StatusCode: 201})
+	backend.AddLog("log-2", &outboxLogPb.HoBomLogPayload{ServiceType: "api", Level: "INFO", TraceId: "trace-1", StatusCode: 500})
+
+	url := registrytest.NewRegistry().Serve(t)
+	cfg := DefaultConfig()
+	cfg.Serializer = serde.NewAvroSerializer(serde.NewRegistryClient(url, nil))
+	pub := &recordingPublisher{}
+	NewLogPoller(backend.Dial(t), pub, nil, newMemoryAckJournal(), cfg).Poll(context.Background())
+
+	if len(pub.events) != 1 {
+		t.Fatalf("expected 1 batch event, got %d", len(pub.events))
+	}
+	if ct := publisher.HeaderValue(pub.events[0].Headers, publisher.HeaderContentType); ct != "application/avro" {
+		t.Errorf("expected avro content-type, got %q", ct)
+	}
+
+	var batch struct {
+		Logs []struct {
+			TraceId     string  `avro:"traceId"`
+			Path        *string `avro:"path"`
+			StatusCode  int     `avro:"statusCode"`
+			PayloadJson string  `avro:"payloadJson"`
+		} `avro:"logs"`
+	}
+	if err := serde.NewAvroSerializer(serde.NewRegistryClient(url, nil)).Deserialize(context.Background(), pub.events[0].Value, &batch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(batch.Logs) != 2 || batch.Logs[0].StatusCode != 201 || batch.Logs[1].StatusCode != 500 {
+		t.Fatalf("unexpected batch: %+v", batch)
+	}
+	if batch.Logs[0].Path == nil || *batch.Logs[0].Path != "/api" {
+		t.Errorf("expected path /api, got %v", batch.Logs[0].Path)
+	}
+	if !json.Valid([]byte(batch.Logs[0].PayloadJson)) {
+		t.Errorf("expected payloadJson to hold JSON, got %q", batch.Logs[0].PayloadJson)
+	}
+}
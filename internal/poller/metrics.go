@@ -0,0 +1,38 @@
+package poller
+
+import (
+	"expvar"
+	"sync"
+)
+
+// Counters published for every poller under the "pollers" expvar map,
+// e.g. pollers.message.panics.
+const (
+	metricCycles     = "cycles"
+	metricItems      = "items"
+	metricErrors     = "errors"
+	metricPanics     = "panics"
+	metricItemPanics = "itemPanics"
+	metricRestarts   = "restarts"
+)
+
+// pollerMetrics holds an *expvar.Map of counters per poller name. expvar
+// serves it on /debug/vars, and on /metrics when the handler is registered
+// there.
+var (
+	pollerMetrics   = expvar.NewMap("pollers")
+	pollerMetricsMu sync.Mutex
+)
+
+// metricsFor returns the counters of the named poller, creating them on
+// first use.
+func metricsFor(name string) *expvar.Map {
+	pollerMetricsMu.Lock()
+	defer pollerMetricsMu.Unlock()
+	if m, ok := pollerMetrics.Get(name).(*expvar.Map); ok {
+		return m
+	}
+	m := new(expvar.Map).Init()
+	pollerMetrics.Set(name, m)
+	return m
+}
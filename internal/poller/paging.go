@@ -0,0 +1,45 @@
+package poller
+
+import "context"
+
+// fetchPageFunc fetches and processes one page of at most limit outbox rows
+// starting at cursor. It returns the number of rows the backend returned, the
+// number of those actually processed, and the cursor of the following page,
+// which is empty once the backlog is exhausted.
+type fetchPageFunc func(ctx context.Context, limit int, cursor string) (fetched, processed int, nextCursor string, err error)
+
+// walkPages fetches pages of pageSize rows until the backend reports no more
+// rows, the poll loops are stopping, or maxPerCycle rows have been processed.
+// Skipped rows do not count against maxPerCycle, so a page of rows awaiting
+// acknowledgement does not hold back the PENDING rows behind it. Each page is
+// processed before the next one is requested, so at most one page is held in
+// memory. Backends that ignore limit and cursor return everything as a single
+// page without a next cursor.
+func walkPages(ctx context.Context, pageSize, maxPerCycle int, fetch fetchPageFunc) PollResult {
+	var (
+		result PollResult
+		cursor string
+	)
+	for {
+		limit := pageSize
+		if maxPerCycle > 0 {
+			limit = min(limit, maxPerCycle-result.Processed)
+		}
+
+		fetched, processed, next, err := fetch(ctx, limit, cursor)
+		result.Processed += processed
+		if err != nil {
+			result.Err = err
+			return result
+		}
+
+		cursor = next
+		if cursor == "" || fetched == 0 {
+			return result
+		}
+		if stopping(ctx) || (maxPerCycle > 0 && result.Processed >= maxPerCycle) {
+			result.More = true
+			return result
+		}
+	}
+}
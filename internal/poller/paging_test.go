@@ -0,0 +1,125 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// pagedBackend serves total rows in pages using the row offset as cursor.
+type pagedBackend struct {
+	total    int
+	requests []string
+	limits   []int
+}
+
+func (b *pagedBackend) fetch(_ context.Context, limit int, cursor string) (int, int, string, error) {
+	b.requests = append(b.requests, cursor)
+	b.limits = append(b.limits, limit)
+
+	offset := 0
+	if cursor != "" {
+		fmt.Sscanf(cursor, "%d", &offset)
+	}
+	n := min(limit, b.total-offset)
+	if offset+n >= b.total {
+		return n, n, "", nil
+	}
+	return n, n, fmt.Sprintf("%d", offset+n), nil
+}
+
+func TestWalkPages_WalksUntilExhausted(t *testing.T) {
+	backend := &pagedBackend{total: 25}
+
+	res := walkPages(context.Background(), 10, 100, backend.fetch)
+
+	if res.Processed != 25 || res.More || res.Err != nil {
+		t.Errorf("expected 25 rows and no more, got %+v", res)
+	}
+	if len(backend.requests) != 3 {
+		t.Errorf("expected 3 page requests, got %d", len(backend.requests))
+	}
+	if backend.requests[0] != "" || backend.requests[1] != "10" || backend.requests[2] != "20" {
+		t.Errorf("expected cursors to follow nextCursor, got %v", backend.requests)
+	}
+}
+
+func TestWalkPages_StopsAtCycleCap(t *testing.T) {
+	backend := &pagedBackend{total: 100}
+
+	res := walkPages(context.Background(), 10, 25, backend.fetch)
+
+	if res.Processed != 25 {
+		t.Errorf("expected cycle capped at 25 rows, got %d", res.Processed)
+	}
+	if !res.More {
+		t.Error("expected More when rows were left behind")
+	}
+	if last := backend.limits[len(backend.limits)-1]; last != 5 {
+		t.Errorf("expected the last page limited to the remaining 5 rows, got %d", last)
+	}
+}
+
+func TestWalkPages_LegacyBackendSinglePage(t *testing.T) {
+	calls := 0
+	fetch := func(context.Context, int, string) (int, int, string, error) {
+		calls++
+		return 1200, 1200, "", nil
+	}
+
+	res := walkPages(context.Background(), 500, 5000, fetch)
+
+	if calls != 1 || res.Processed != 1200 || res.More {
+		t.Errorf("expected one unpaged fetch of 1200 rows, got calls=%d res=%+v", calls, res)
+	}
+}
+
+func TestWalkPages_ErrorStopsCycle(t *testing.T) {
+	fetchErr := errors.New("unavailable")
+	calls := 0
+	fetch := func(context.Context, int, string) (int, int, string, error) {
+		calls++
+		if calls == 2 {
+			return 0, 0, "", fetchErr
+		}
+		return 10, 10, "next", nil
+	}
+
+	res := walkPages(context.Background(), 10, 100, fetch)
+
+	if !errors.Is(res.Err, fetchErr) || res.Processed != 10 {
+		t.Errorf("expected error after first page, got %+v", res)
+	}
+}
+
+func TestWalkPages_CancelledContextStopsAfterCurrentPage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	backend := &pagedBackend{total: 100}
+	fetch := func(ctx context.Context, limit int, cursor string) (int, int, string, error) {
+		defer cancel()
+		return backend.fetch(ctx, limit, cursor)
+	}
+
+	res := walkPages(ctx, 10, 0, fetch)
+
+	if len(backend.requests) != 1 || !res.More {
+		t.Errorf("expected a single page and More after cancel, got %d requests, res=%+v", len(backend.requests), res)
+	}
+}
+
+func TestWalkPages_StopSignalEndsCycleAfterCurrentPage(t *testing.T) {
+	backend := &pagedBackend{total: 100}
+	stop := make(chan struct{})
+	ctx := withStop(context.Background(), stop)
+	fetch := func(ctx context.Context, limit int, cursor string) (int, int, string, error) {
+		close(stop)
+		return backend.fetch(ctx, limit, cursor)
+	}
+
+	res := walkPages(ctx, 10, 100, fetch)
+
+	if len(backend.requests) != 1 || res.Processed != 10 || !res.More {
+		t.Errorf("expected only the current page once stopping, got %d requests and %+v", len(backend.requests), res)
+	}
+}
@@ -3,50 +3,43 @@ package poller
 import (
 	"context"
 	"log/slog"
-	"sync"
-	"time"
 
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
 	publisher "github.com/HoBom-s/hobom-event-processor/infra/kafka/publisher"
 	redis "github.com/HoBom-s/hobom-event-processor/infra/redis"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox"
 	"google.golang.org/grpc"
 )
 
-const pollingInterval = 5 * time.Second
-
 // Poller is the interface implemented by all event pollers.
 // Poll executes a single polling cycle and returns when complete.
 type Poller interface {
-	Poll(ctx context.Context)
+	Poll(ctx context.Context) PollResult
 }
 
-// StartAllPollers starts all pollers in background goroutines and returns a WaitGroup.
-// Callers must cancel ctx then call wg.Wait() to ensure all in-flight poll cycles complete
-// before shutting down.
-func StartAllPollers(ctx context.Context, conn *grpc.ClientConn, kafkaPublisher publisher.KafkaPublisher, dlqStore redis.DLQStore) *sync.WaitGroup {
-	pollers := []Poller{
-		NewMessagePoller(conn, kafkaPublisher, dlqStore),
-		NewLogPoller(conn, kafkaPublisher, dlqStore),
-	}
-
-	var wg sync.WaitGroup
-	for _, p := range pollers {
-		wg.Add(1)
-		p := p
-		go func() {
-			defer wg.Done()
-			ticker := time.NewTicker(pollingInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ticker.C:
-					p.Poll(ctx)
-				case <-ctx.Done():
-					return
-				}
-			}
-		}()
-	}
-
-	slog.Info("all pollers started")
-	return &wg
+// StartAllPollers starts all pollers in background goroutines and returns the
+// Supervisor of their poll loops. Cancelling ctx stops the pollers from
+// fetching new rows; callers must then call Supervisor.Drain so the rows
+// already claimed are published and settled before shutting down. A poll
+// loop whose cycle panics is restarted with backoff.
+func StartAllPollers(ctx context.Context, conn *grpc.ClientConn, kafkaPublisher publisher.KafkaPublisher, dlqStore redis.DLQStore, ackJournal redis.AckJournal, cfg Config) *Supervisor {
+	supervisor := newSupervisor(cfg)
+	supervisor.add(MessagePollerName, NewMessagePoller(conn, kafkaPublisher, dlqStore, ackJournal, cfg))
+	supervisor.add(LogPollerName, NewLogPoller(conn, kafkaPublisher, dlqStore, ackJournal, cfg))
+
+	supervisor.run(ctx)
+
+	// Lease가 만료된 IN_FLIGHT Row 들을 PENDING 으로 되돌리는 Reaper 를 실행한다.
+	reaper := outbox.NewClient(outboxPb.NewPatchOutboxControllerClient(conn), cfg.InstanceId, cfg.LeaseDuration)
+	supervisor.spawn(func() {
+		runReaper(ctx, reaper, cfg.ReaperInterval, EventTypeHoBomMessage, EventTypeHoBomLog)
+	})
+
+	// 발행 후 SENT 반영에 실패한 Event 들의 Outbox 상태를 재시도하는 Reconciler 를 실행한다.
+	supervisor.spawn(func() {
+		runAckReconciler(ctx, reaper, ackJournal, cfg.AckRetryInterval, cfg.MaxAckRetryInterval, cfg.AckRetention)
+	})
+
+	slog.Info("all pollers started", "instanceId", cfg.InstanceId)
+	return supervisor
 }
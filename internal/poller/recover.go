@@ -0,0 +1,99 @@
+package poller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+)
+
+// stackFrames is the number of frames kept in the stack summary of a panic.
+const stackFrames = 3
+
+// PanicError is a panic recovered while polling, converted into an error.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack summarises the frames that panicked, innermost first, e.g.
+	// poller.(*logPoller).prepare (log_poller.go:130).
+	Stack string
+}
+
+func (e *PanicError) Error() string {
+	if e.Stack == "" {
+		return fmt.Sprintf("panic: %v", e.Value)
+	}
+	return fmt.Sprintf("panic: %v [%s]", e.Value, e.Stack)
+}
+
+// newPanicError captures the stack of the panicking goroutine. It must be
+// called from the deferred function that recovered r.
+func newPanicError(r any) *PanicError {
+	if perr, ok := r.(*PanicError); ok {
+		return perr
+	}
+	return &PanicError{Value: r, Stack: summarizeStack(debug.Stack())}
+}
+
+// recoverItem calls fn for a single outbox row of the named poller. A panic
+// is counted and returned as a *PanicError, so only that row is marked FAILED.
+func recoverItem[T any](name string, fn func() (T, error)) (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			perr := newPanicError(r)
+			metricsFor(name).Add(metricItemPanics, 1)
+			slog.Error("recovered panic while processing outbox row", "poller", name, "err", perr)
+			err = perr
+		}
+	}()
+	return fn()
+}
+
+// safePoll runs a single poll cycle and converts a panic into a *PanicError.
+func safePoll(ctx context.Context, p Poller) (res PollResult, perr *PanicError) {
+	defer func() {
+		if r := recover(); r != nil {
+			perr = newPanicError(r)
+			res = PollResult{Err: perr}
+		}
+	}()
+	return p.Poll(ctx), nil
+}
+
+// summarizeStack returns the innermost frames of a debug.Stack trace below
+// the call to panic, as "function (file:line)" joined by " < ".
+func summarizeStack(stack []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+
+	// panic 호출 이후의 Frame 부터 요약하도록 한다. 찾지 못한 경우 전체 Frame 을 사용한다.
+	start := 1
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic(") {
+			start = i + 2
+			break
+		}
+	}
+
+	var frames []string
+	for i := start; i+1 < len(lines) && len(frames) < stackFrames; i += 2 {
+		fn := lines[i]
+		if strings.HasPrefix(fn, "runtime") || strings.HasPrefix(fn, "created by") {
+			continue
+		}
+		if j := strings.LastIndex(fn, "("); j > 0 {
+			fn = fn[:j]
+		}
+		if j := strings.LastIndex(fn, "/"); j >= 0 {
+			fn = fn[j+1:]
+		}
+
+		location := strings.TrimSpace(lines[i+1])
+		if j := strings.LastIndex(location, " +0x"); j >= 0 {
+			location = location[:j]
+		}
+		frames = append(frames, fmt.Sprintf("%s (%s)", fn, filepath.Base(location)))
+	}
+	return strings.Join(frames, " < ")
+}
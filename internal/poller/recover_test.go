@@ -0,0 +1,151 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"github.com/HoBom-s/hobom-event-processor/infra/kafka/serde"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox/outboxtest"
+)
+
+// panickingSerializer panics while encoding messages titled "boom".
+type panickingSerializer struct {
+	serde.JSONSerializer
+}
+
+func (s panickingSerializer) Serialize(ctx context.Context, topic string, v any) ([]byte, error) {
+	if cmd, ok := v.(DeliverHoBomMessageCommand); ok && cmd.Title == "boom" {
+		var m map[string]int
+		m["boom"]++
+	}
+	return s.JSONSerializer.Serialize(ctx, topic, v)
+}
+
+// flakyPoller panics on its first panics cycles, then completes.
+type flakyPoller struct {
+	panics int32
+	calls  atomic.Int32
+}
+
+func (p *flakyPoller) Poll(context.Context) PollResult {
+	if p.calls.Add(1) <= p.panics {
+		panic("flaky poller")
+	}
+	return PollResult{}
+}
+
+func metricValue(name, key string) int64 {
+	if v, ok := metricsFor(name).Get(key).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestRecoverItem_ReturnsPanicWithStack(t *testing.T) {
+	before := metricValue("recover-test", metricItemPanics)
+	_, err := recoverItem("recover-test", func() (int, error) {
+		var p *outboxPb.QueryResult
+		return len(p.Payload.Title), nil
+	})
+
+	var perr *PanicError
+	if !errors.As(err, &perr) {
+		t.Fatalf("expected a PanicError, got %v", err)
+	}
+	if !strings.Contains(perr.Stack, "TestRecoverItem_ReturnsPanicWithStack") || !strings.Contains(perr.Stack, "recover_test.go:") {
+		t.Errorf("expected the stack summary to name the panicking frame, got %q", perr.Stack)
+	}
+	if got := metricValue("recover-test", metricItemPanics) - before; got != 1 {
+		t.Errorf("expected 1 item panic counted, got %d", got)
+	}
+}
+
+func TestRunLanes_ReraisesWorkerPanic(t *testing.T) {
+	defer func() {
+		var perr *PanicError
+		if r := recover(); r == nil {
+			t.Fatal("expected runLanes to panic")
+		} else if perr, _ = r.(*PanicError); perr == nil || perr.Value != "lane" {
+			t.Errorf("expected the lane panic, got %v", r)
+		}
+	}()
+
+	runLanes(context.Background(), [][]int{{1}, {2}}, 1, func(_ context.Context, chunk []int) {
+		if chunk[0] == 2 {
+			panic("lane")
+		}
+	})
+}
+
+func TestMessagePoller_PanickingRowIsMarkedFailed(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("boom", &outboxPb.MessagePayload{Title: "boom", Recipient: "user-1"})
+	backend.AddMessage("fine", &outboxPb.MessagePayload{Title: "fine", Recipient: "user-1"})
+
+	cfg := DefaultConfig()
+	cfg.Serializer = panickingSerializer{}
+	pub := &recordingPublisher{}
+	NewMessagePoller(backend.Dial(t), pub, nil, newMemoryAckJournal(), cfg).Poll(context.Background())
+
+	if counts := pub.countByKey(); len(counts) != 1 || counts["fine"] != 1 {
+		t.Errorf("expected only the fine event published, got %v", counts)
+	}
+	row, _ := backend.Row("boom")
+	if row.Status != OutboxFailed || !strings.Contains(row.LastError, "panic: assignment to entry in nil map") {
+		t.Errorf("expected FAILED with the panic, got %s %q", row.Status, row.LastError)
+	}
+}
+
+func TestSupervisor_RestartsAfterPanicsAndReportsReadiness(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.PollInterval = time.Millisecond
+	cfg.MaxPollInterval = time.Millisecond
+	cfg.PollJitter = 0
+	cfg.RestartBackoff = time.Millisecond
+	cfg.MaxRestartBackoff = 2 * time.Millisecond
+	cfg.MaxConsecutivePanics = 2
+
+	supervisor := newSupervisor(cfg)
+	p := &flakyPoller{panics: 2}
+	supervisor.add("flaky", p)
+	ctx, cancel := context.WithCancel(context.Background())
+	supervisor.run(ctx)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for p.calls.Load() < 4 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	supervisor.Drain(context.Background())
+
+	status := supervisor.Status()[0]
+	if status.Panics != 2 || status.Restarts != 2 || status.Cycles < 1 {
+		t.Errorf("expected 2 panics, 2 restarts and a completed cycle, got %+v", status)
+	}
+	if status.State != LoopStopped {
+		t.Errorf("expected the loop stopped, got %s", status.State)
+	}
+	if err := supervisor.Ready(context.Background()); err != nil {
+		t.Errorf("expected ready once a cycle completed, got %v", err)
+	}
+
+	supervisor.update("flaky", func(l *loop) { l.status.ConsecutivePanics = 2 })
+	if err := supervisor.Ready(context.Background()); err == nil || !strings.Contains(err.Error(), "flaky poller panicked 2 cycles in a row") {
+		t.Errorf("expected not ready after repeated panics, got %v", err)
+	}
+}
+
+func TestRestartBackoff(t *testing.T) {
+	cfg := Config{RestartBackoff: time.Second, MaxRestartBackoff: 5 * time.Second}
+	for consecutive, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		if got := restartBackoff(cfg, consecutive); got != want {
+			t.Errorf("restartBackoff(%d) = %v, want %v", consecutive, got, want)
+		}
+	}
+}
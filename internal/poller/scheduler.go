@@ -0,0 +1,74 @@
+package poller
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// PollResult summarises a single poll cycle for the scheduler.
+type PollResult struct {
+	// Processed is the number of outbox rows the cycle published or marked
+	// FAILED. Rows skipped because they await acknowledgement or were claimed
+	// elsewhere are not counted.
+	Processed int
+	// More reports that the cycle stopped before the backend ran out of rows.
+	More bool
+	// Err is the error that aborted the cycle, if any.
+	Err error
+}
+
+// pollScheduler decides how long a poller waits before its next cycle.
+// A cycle that left rows behind or processed a full page means more backlog is
+// waiting, so the next cycle starts immediately. An empty or failed cycle doubles the wait up to maxInterval,
+// and any other cycle resets it to the base interval.
+type pollScheduler struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	pageSize    int
+	jitter      float64
+	current     time.Duration
+	randFloat   func() float64
+}
+
+func newPollScheduler(cfg Config) *pollScheduler {
+	return &pollScheduler{
+		interval:    cfg.PollInterval,
+		maxInterval: max(cfg.MaxPollInterval, cfg.PollInterval),
+		pageSize:    cfg.PageSize,
+		jitter:      cfg.PollJitter,
+		current:     cfg.PollInterval,
+		randFloat:   rand.Float64,
+	}
+}
+
+// initial returns the delay before the first cycle. It is jittered so that
+// replicas started together do not poll in lockstep.
+func (s *pollScheduler) initial() time.Duration {
+	return s.withJitter(s.interval)
+}
+
+// next returns the delay before the cycle following res.
+func (s *pollScheduler) next(res PollResult) time.Duration {
+	if res.Err == nil && (res.More || (s.pageSize > 0 && res.Processed >= s.pageSize)) {
+		s.current = s.interval
+		return 0
+	}
+
+	if res.Err != nil || res.Processed == 0 {
+		delay := s.current
+		s.current = min(s.current*2, s.maxInterval)
+		return s.withJitter(delay)
+	}
+
+	s.current = s.interval
+	return s.withJitter(s.interval)
+}
+
+// withJitter spreads d uniformly over [d*(1-jitter), d*(1+jitter)].
+func (s *pollScheduler) withJitter(d time.Duration) time.Duration {
+	if s.jitter <= 0 {
+		return d
+	}
+	factor := 1 + s.jitter*(2*s.randFloat()-1)
+	return time.Duration(float64(d) * factor)
+}
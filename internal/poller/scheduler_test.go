@@ -0,0 +1,84 @@
+package poller
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestScheduler() *pollScheduler {
+	cfg := DefaultConfig()
+	cfg.PollJitter = 0
+	return newPollScheduler(cfg)
+}
+
+func TestPollScheduler_FullPageRepollsImmediately(t *testing.T) {
+	s := newTestScheduler()
+
+	if got := s.next(PollResult{Processed: 500}); got != 0 {
+		t.Errorf("expected immediate re-poll after a full page, got %v", got)
+	}
+}
+
+func TestPollScheduler_PartialPageUsesBaseInterval(t *testing.T) {
+	s := newTestScheduler()
+
+	if got := s.next(PollResult{Processed: 10}); got != 5*time.Second {
+		t.Errorf("expected base interval, got %v", got)
+	}
+}
+
+func TestPollScheduler_IdleBacksOffToMax(t *testing.T) {
+	s := newTestScheduler()
+	want := []time.Duration{
+		5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute,
+	}
+
+	for i, w := range want {
+		if got := s.next(PollResult{}); got != w {
+			t.Errorf("empty cycle %d: expected %v, got %v", i+1, w, got)
+		}
+	}
+}
+
+func TestPollScheduler_ErrorBacksOff(t *testing.T) {
+	s := newTestScheduler()
+	s.next(PollResult{Err: errors.New("unavailable")})
+
+	if got := s.next(PollResult{Err: errors.New("unavailable")}); got != 10*time.Second {
+		t.Errorf("expected backoff after repeated errors, got %v", got)
+	}
+}
+
+func TestPollScheduler_WorkResetsBackoff(t *testing.T) {
+	s := newTestScheduler()
+	s.next(PollResult{})
+	s.next(PollResult{})
+
+	s.next(PollResult{Processed: 3})
+
+	if got := s.next(PollResult{}); got != 5*time.Second {
+		t.Errorf("expected backoff reset to base interval, got %v", got)
+	}
+}
+
+func TestPollScheduler_JitterStaysInBounds(t *testing.T) {
+	cfg := DefaultConfig()
+	s := newPollScheduler(cfg)
+
+	for _, r := range []float64{0, 0.5, 0.999} {
+		s.randFloat = func() float64 { return r }
+		got := s.withJitter(10 * time.Second)
+		if got < 8*time.Second || got > 12*time.Second {
+			t.Errorf("rand=%v: jittered delay %v outside ±20%%", r, got)
+		}
+	}
+}
+
+func TestPollScheduler_MoreRepollsImmediately(t *testing.T) {
+	s := newTestScheduler()
+
+	if got := s.next(PollResult{Processed: 20, More: true}); got != 0 {
+		t.Errorf("expected immediate re-poll when rows were left behind, got %v", got)
+	}
+}
@@ -0,0 +1,365 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MessagePollerName is the name of the message poller in metrics, the
+	// supervisor API and readiness reports.
+	MessagePollerName = "message"
+	// LogPollerName is the name of the log poller in metrics, the supervisor
+	// API and readiness reports.
+	LogPollerName = "log"
+)
+
+// LoopState is what a poll loop is doing.
+type LoopState string
+
+const (
+	// LoopIdle is a loop waiting for its next cycle.
+	LoopIdle LoopState = "idle"
+	// LoopPolling is a loop running a cycle.
+	LoopPolling LoopState = "polling"
+	// LoopPaused is a loop that runs no cycles until it is resumed, except
+	// cycles triggered manually.
+	LoopPaused LoopState = "paused"
+	// LoopRestarting is a loop waiting to restart after a cycle panicked.
+	LoopRestarting LoopState = "restarting"
+	// LoopStopped is a loop that stopped because its context was cancelled.
+	LoopStopped LoopState = "stopped"
+)
+
+var (
+	// ErrUnknownPoller is returned for a poller name the Supervisor does not run.
+	ErrUnknownPoller = errors.New("unknown poller")
+	// ErrTriggerPending is returned when a manual cycle is already queued.
+	ErrTriggerPending = errors.New("a triggered cycle is already pending")
+)
+
+// LoopStatus is the state of a poll loop.
+type LoopStatus struct {
+	Name  string    `json:"name"`
+	State LoopState `json:"state"`
+	// Cycles is the number of completed poll cycles.
+	Cycles int64 `json:"cycles"`
+	// ItemsProcessed is the number of outbox rows fetched by completed cycles.
+	ItemsProcessed int64 `json:"itemsProcessed"`
+	// Panics is the number of cycles that panicked.
+	Panics int64 `json:"panics"`
+	// ConsecutivePanics is the number of cycles that panicked since the last
+	// cycle that completed.
+	ConsecutivePanics int `json:"consecutivePanics"`
+	// Restarts is the number of times the loop was restarted after a panic.
+	Restarts int64 `json:"restarts"`
+	// LastRunAt is when the last cycle, completed or not, started.
+	LastRunAt time.Time `json:"lastRunAt,omitzero"`
+	// LastCycleAt is when the last completed cycle finished.
+	LastCycleAt time.Time `json:"lastCycleAt,omitzero"`
+	// LastError is the error of the last completed cycle, if any.
+	LastError   string    `json:"lastError,omitempty"`
+	LastPanic   string    `json:"lastPanic,omitempty"`
+	LastPanicAt time.Time `json:"lastPanicAt,omitzero"`
+}
+
+// loop is a poll loop run by the Supervisor.
+type loop struct {
+	name   string
+	poller Poller
+	status LoopStatus
+	paused bool
+
+	// Resume 와 수동 실행 요청은 버퍼가 1인 채널로 전달하여, 요청이 중복되어도 한 번만 처리되도록 한다.
+	resume  chan struct{}
+	trigger chan struct{}
+}
+
+// Supervisor runs the poll loops started by StartAllPollers. It reports
+// their status, pauses, resumes and triggers them, reports the service not
+// ready while a loop keeps crashing, and drains the loops on shutdown.
+type Supervisor struct {
+	cfg Config
+
+	mu    sync.Mutex
+	loops map[string]*loop
+
+	// wg 는 Poll Loop 와 Reaper 등 Supervisor 가 실행한 모든 Goroutine 을 기다린다.
+	wg         sync.WaitGroup
+	cancelWork context.CancelFunc
+}
+
+func newSupervisor(cfg Config) *Supervisor {
+	return &Supervisor{
+		cfg:   cfg,
+		loops: make(map[string]*loop),
+	}
+}
+
+// add registers p under name. It must be called before run.
+func (s *Supervisor) add(name string, p Poller) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loops[name] = &loop{
+		name:    name,
+		poller:  p,
+		status:  LoopStatus{Name: name, State: LoopIdle},
+		resume:  make(chan struct{}, 1),
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Status returns the state of every loop, sorted by name.
+func (s *Supervisor) Status() []LoopStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]LoopStatus, 0, len(s.loops))
+	for _, l := range s.loops {
+		statuses = append(statuses, l.status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// Pause stops the named loop from starting scheduled cycles. A cycle that
+// is running finishes first.
+func (s *Supervisor) Pause(name string) error {
+	return s.update(name, func(l *loop) {
+		l.paused = true
+		if l.status.State == LoopIdle {
+			l.status.State = LoopPaused
+		}
+	})
+}
+
+// Resume lets a paused loop run again, starting with a cycle right away.
+func (s *Supervisor) Resume(name string) error {
+	return s.update(name, func(l *loop) {
+		if !l.paused {
+			return
+		}
+		l.paused = false
+		if l.status.State == LoopPaused {
+			l.status.State = LoopIdle
+		}
+		notify(l.resume)
+	})
+}
+
+// Trigger queues one cycle of the named loop to run as soon as the loop is
+// free, even while it is paused.
+func (s *Supervisor) Trigger(name string) error {
+	var pending bool
+	err := s.update(name, func(l *loop) { pending = !notify(l.trigger) })
+	if err == nil && pending {
+		return ErrTriggerPending
+	}
+	return err
+}
+
+// Ready returns an error naming the loops whose last MaxConsecutivePanics
+// cycles all panicked.
+func (s *Supervisor) Ready(context.Context) error {
+	if s.cfg.MaxConsecutivePanics <= 0 {
+		return nil
+	}
+	var crashing []string
+	for _, status := range s.Status() {
+		if status.ConsecutivePanics >= s.cfg.MaxConsecutivePanics {
+			crashing = append(crashing, fmt.Sprintf("%s poller panicked %d cycles in a row: %s", status.Name, status.ConsecutivePanics, status.LastPanic))
+		}
+	}
+	if len(crashing) > 0 {
+		return errors.New(strings.Join(crashing, "; "))
+	}
+	return nil
+}
+
+func (s *Supervisor) update(name string, fn func(*loop)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.loops[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownPoller, name)
+	}
+	fn(l)
+	return nil
+}
+
+// setState records the state of l, shown as paused while it is idle and
+// paused.
+func (s *Supervisor) setState(l *loop, state LoopState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if state == LoopIdle && l.paused {
+		state = LoopPaused
+	}
+	l.status.State = state
+}
+
+func (s *Supervisor) isPaused(l *loop) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return l.paused
+}
+
+// run runs every loop on its own goroutine until ctx is cancelled. Cycles
+// run under a separate work context that ctx only stops from fetching new
+// pages, so the rows they have claimed are published and settled; it is
+// cancelled by Drain.
+func (s *Supervisor) run(ctx context.Context) {
+	work, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	work = withStop(work, ctx.Done())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancelWork = cancel
+	for _, l := range s.loops {
+		s.spawn(func() { s.runLoop(ctx, work, l) })
+	}
+}
+
+// spawn runs fn on a goroutine that Drain waits for.
+func (s *Supervisor) spawn(fn func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		fn()
+	}()
+}
+
+// runLoop runs l until ctx is cancelled, polling under work. A cycle that
+// panics stops the loop; it is restarted with a fresh scheduler after a
+// backoff that doubles with every consecutive panic, from RestartBackoff up
+// to MaxRestartBackoff.
+func (s *Supervisor) runLoop(ctx, work context.Context, l *loop) {
+	defer s.setState(l, LoopStopped)
+	for {
+		perr := s.runSchedule(ctx, work, l)
+		if perr == nil {
+			return
+		}
+
+		var consecutive int
+		s.update(l.name, func(l *loop) {
+			consecutive = l.status.ConsecutivePanics
+			l.status.State = LoopRestarting
+		})
+		delay := restartBackoff(s.cfg, consecutive)
+		slog.Error("poll loop crashed, restarting", "poller", l.name, "err", perr, "consecutive", consecutive, "backoff", delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+		metricsFor(l.name).Add(metricRestarts, 1)
+		s.update(l.name, func(l *loop) { l.status.Restarts++ })
+	}
+}
+
+// runSchedule polls under work on the adaptive schedule until ctx is
+// cancelled, in which case it returns nil, or until a cycle panics.
+// Scheduled cycles are skipped while the loop is paused; triggered cycles
+// always run.
+func (s *Supervisor) runSchedule(ctx, work context.Context, l *loop) *PanicError {
+	scheduler := newPollScheduler(s.cfg)
+	timer := time.NewTimer(scheduler.initial())
+	defer timer.Stop()
+	s.setState(l, LoopIdle)
+	for {
+		select {
+		case <-timer.C:
+			// 일시 정지 중에는 Timer 를 다시 설정하지 않고, Resume 또는 수동 실행을 기다린다.
+			if s.isPaused(l) {
+				continue
+			}
+		case <-l.resume:
+			if s.isPaused(l) {
+				continue
+			}
+		case <-l.trigger:
+		case <-ctx.Done():
+			return nil
+		}
+		// Timer 와 종료 신호가 동시에 도착한 경우, 새로운 Cycle 을 시작하지 않는다.
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		res, perr := s.cycle(work, l)
+		if perr != nil {
+			return perr
+		}
+		timer.Reset(scheduler.next(res))
+	}
+}
+
+// cycle runs one poll cycle of l and records its outcome.
+func (s *Supervisor) cycle(ctx context.Context, l *loop) (PollResult, *PanicError) {
+	metrics := metricsFor(l.name)
+	s.update(l.name, func(l *loop) {
+		l.status.State = LoopPolling
+		l.status.LastRunAt = time.Now()
+	})
+
+	res, perr := safePoll(ctx, l.poller)
+	now := time.Now()
+	if perr != nil {
+		metrics.Add(metricPanics, 1)
+		s.update(l.name, func(l *loop) {
+			l.status.Panics++
+			l.status.ConsecutivePanics++
+			l.status.LastPanic = perr.Error()
+			l.status.LastPanicAt = now
+		})
+		return res, perr
+	}
+
+	metrics.Add(metricCycles, 1)
+	metrics.Add(metricItems, int64(res.Processed))
+	if res.Err != nil {
+		metrics.Add(metricErrors, 1)
+	}
+	s.update(l.name, func(l *loop) {
+		l.status.Cycles++
+		l.status.ItemsProcessed += int64(res.Processed)
+		l.status.ConsecutivePanics = 0
+		l.status.LastCycleAt = now
+		l.status.LastError = ""
+		if res.Err != nil {
+			l.status.LastError = res.Err.Error()
+		}
+	})
+	s.setState(l, LoopIdle)
+	return res, nil
+}
+
+// notify sends on a channel with a buffer of one without blocking and
+// reports whether the signal was queued.
+func notify(ch chan struct{}) bool {
+	select {
+	case ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// restartBackoff returns the wait before restarting a loop after its
+// consecutive-th panic in a row.
+func restartBackoff(cfg Config, consecutive int) time.Duration {
+	delay := cfg.RestartBackoff
+	for i := 1; i < consecutive && delay < cfg.MaxRestartBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, max(cfg.MaxRestartBackoff, cfg.RestartBackoff))
+}
@@ -0,0 +1,113 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingPoller counts its cycles, each fetching fetched rows.
+type countingPoller struct {
+	fetched int
+	calls   atomic.Int32
+}
+
+func (p *countingPoller) Poll(context.Context) PollResult {
+	p.calls.Add(1)
+	return PollResult{Processed: p.fetched}
+}
+
+// startSupervisor runs p under a Supervisor whose scheduled cycles are an
+// hour apart, so only triggered and resumed cycles run during the test.
+func startSupervisor(t *testing.T, p Poller) *Supervisor {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.PollInterval = time.Hour
+	cfg.MaxPollInterval = time.Hour
+	cfg.PollJitter = 0
+	supervisor := newSupervisor(cfg)
+	supervisor.add("counting", p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	supervisor.run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		supervisor.Drain(context.Background())
+	})
+	return supervisor
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSupervisor_TriggerRunsOneCycle(t *testing.T) {
+	p := &countingPoller{fetched: 3}
+	supervisor := startSupervisor(t, p)
+
+	if err := supervisor.Trigger("counting"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitFor(t, func() bool { return supervisor.Status()[0].Cycles == 1 })
+
+	status := supervisor.Status()[0]
+	if status.ItemsProcessed != 3 || status.LastRunAt.IsZero() || status.State != LoopIdle {
+		t.Errorf("unexpected status after a triggered cycle: %+v", status)
+	}
+}
+
+func TestSupervisor_PauseSkipsScheduledCyclesUntilResumed(t *testing.T) {
+	p := &countingPoller{}
+	supervisor := startSupervisor(t, p)
+
+	if err := supervisor.Pause("counting"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state := supervisor.Status()[0].State; state != LoopPaused {
+		t.Errorf("expected paused, got %s", state)
+	}
+
+	// 일시 정지 중에도 수동 실행은 수행되어야 한다.
+	supervisor.Trigger("counting")
+	waitFor(t, func() bool { return p.calls.Load() == 1 })
+	waitFor(t, func() bool { return supervisor.Status()[0].State == LoopPaused })
+
+	if err := supervisor.Resume("counting"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitFor(t, func() bool { return p.calls.Load() == 2 })
+	waitFor(t, func() bool { return supervisor.Status()[0].State == LoopIdle })
+}
+
+func TestSupervisor_UnknownPoller(t *testing.T) {
+	supervisor := startSupervisor(t, &countingPoller{})
+
+	for _, fn := range []func(string) error{supervisor.Pause, supervisor.Resume, supervisor.Trigger} {
+		if err := fn("missing"); !errors.Is(err, ErrUnknownPoller) {
+			t.Errorf("expected ErrUnknownPoller, got %v", err)
+		}
+	}
+}
+
+func TestSupervisor_TriggerWhilePending(t *testing.T) {
+	supervisor := newSupervisor(DefaultConfig())
+	supervisor.add("counting", &countingPoller{})
+
+	// Loop 가 실행되지 않았으므로 첫 요청은 대기열에 남는다.
+	if err := supervisor.Trigger("counting"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := supervisor.Trigger("counting"); !errors.Is(err, ErrTriggerPending) {
+		t.Errorf("expected ErrTriggerPending, got %v", err)
+	}
+}
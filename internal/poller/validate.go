@@ -0,0 +1,147 @@
+package poller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Violation is a rule broken by an outbox payload.
+type Violation struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError reports every rule broken by the payload of an outbox
+// event. Invalid events are marked FAILED and saved under the dlq:invalid:
+// namespace instead of being published.
+type ValidationError struct {
+	EventType  string
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + " " + v.Message
+	}
+	return fmt.Sprintf("invalid %s payload: %s", e.EventType, strings.Join(parts, "; "))
+}
+
+// violations collects the rules broken while validating a payload.
+type violations []Violation
+
+func (v *violations) add(field, message string) {
+	*v = append(*v, Violation{Field: field, Message: message})
+}
+
+func (v *violations) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "must not be empty")
+	}
+}
+
+func (v violations) err(eventType string) error {
+	if len(v) == 0 {
+		return nil
+	}
+	return &ValidationError{EventType: eventType, Violations: v}
+}
+
+// Validate checks the command against the rules of MESSAGE events:
+// type must be MAIL_MESSAGE or PUSH_MESSAGE, and title and recipient must
+// not be empty.
+func (c DeliverHoBomMessageCommand) Validate() error {
+	var v violations
+	if c.Type != Mail && c.Type != Push {
+		v.add("type", fmt.Sprintf("must be %s or %s", Mail, Push))
+	}
+	v.required("title", c.Title)
+	v.required("recipient", c.Recipient)
+	return v.err(EventTypeHoBomMessage)
+}
+
+// Validate checks the command against the rules of HOBOM_LOG events:
+// serviceType and level must not be empty, and statusCode is either unset
+// or a valid HTTP status.
+func (c HoBomLogMessageCommand) Validate() error {
+	var v violations
+	c.collect(&v, "")
+	return v.err(EventTypeHoBomLog)
+}
+
+func (c HoBomLogMessageCommand) collect(v *violations, prefix string) {
+	v.required(prefix+"serviceType", c.ServiceType)
+	v.required(prefix+"level", c.Level)
+	if c.StatusCode != 0 && (c.StatusCode < 100 || c.StatusCode > 599) {
+		v.add(prefix+"statusCode", "must be between 100 and 599")
+	}
+}
+
+// Validate checks every command of the batch. Violations are reported with
+// the index of the command, e.g. [1].level.
+func (b HoBomLogBatch) Validate() error {
+	var v violations
+	if len(b) == 0 {
+		v.add("logs", "must not be empty")
+	}
+	for i, c := range b {
+		c.collect(&v, fmt.Sprintf("[%d].", i))
+	}
+	return v.err(EventTypeHoBomLog)
+}
+
+// missingPayload is the error for outbox rows without a payload.
+func missingPayload(eventType string) error {
+	return violations{{Field: "payload", Message: "must not be null"}}.err(eventType)
+}
+
+// ValidatePayload validates a JSON command as published to topic, e.g. a DLQ
+// entry that is about to be replayed. Payloads of unknown topics are
+// accepted as is.
+func ValidatePayload(topic string, payload []byte) error {
+	return validatePayload(topic, payload, false)
+}
+
+// ValidateSchema validates a JSON command written by hand, e.g. an edited DLQ
+// entry, more strictly than ValidatePayload: fields the command does not
+// define are rejected, and payloads of unknown topics must still be JSON.
+func ValidateSchema(topic string, payload []byte) error {
+	if !json.Valid(payload) {
+		return errors.New("payload is not valid JSON")
+	}
+	return validatePayload(topic, payload, true)
+}
+
+func validatePayload(topic string, payload []byte, strict bool) error {
+	switch topic {
+	case HoBomMessage:
+		var cmd *DeliverHoBomMessageCommand
+		if err := decode(payload, &cmd, strict); err != nil {
+			return fmt.Errorf("invalid %s payload: %w", EventTypeHoBomMessage, err)
+		}
+		if cmd == nil {
+			return missingPayload(EventTypeHoBomMessage)
+		}
+		return cmd.Validate()
+	case HoBomLog:
+		var batch HoBomLogBatch
+		if err := decode(payload, &batch, strict); err != nil {
+			return fmt.Errorf("invalid %s payload: %w", EventTypeHoBomLog, err)
+		}
+		return batch.Validate()
+	default:
+		return nil
+	}
+}
+
+func decode(payload []byte, v any, strict bool) error {
+	if !strict {
+		return json.Unmarshal(payload, v)
+	}
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
@@ -0,0 +1,168 @@
+package poller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	outboxLogPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/log/outbox/v1"
+	outboxPb "github.com/HoBom-s/hobom-event-processor/infra/grpc/message/outbox/v1"
+	"github.com/HoBom-s/hobom-event-processor/internal/outbox/outboxtest"
+)
+
+func TestDeliverHoBomMessageCommand_Validate(t *testing.T) {
+	valid := DeliverHoBomMessageCommand{Type: Mail, Title: "title", Recipient: "user-1"}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := DeliverHoBomMessageCommand{Type: "FAX", Title: " "}.Validate()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a ValidationError, got %v", err)
+	}
+	var fields []string
+	for _, v := range verr.Violations {
+		fields = append(fields, v.Field)
+	}
+	if strings.Join(fields, ",") != "type,title,recipient" {
+		t.Errorf("expected type, title and recipient violations, got %v", fields)
+	}
+}
+
+func TestHoBomLogBatch_ValidateReportsIndexes(t *testing.T) {
+	batch := HoBomLogBatch{
+		{ServiceType: "api", Level: "INFO", StatusCode: 200},
+		{ServiceType: "api", StatusCode: 42},
+	}
+
+	err := batch.Validate()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	want := "invalid HOBOM_LOG payload: [1].level must not be empty; [1].statusCode must be between 100 and 599"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err, want)
+	}
+}
+
+func TestValidatePayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		topic   string
+		payload string
+		wantErr bool
+	}{
+		{"valid message", HoBomMessage, `{"type":"MAIL_MESSAGE","title":"t","recipient":"r"}`, false},
+		{"null message", HoBomMessage, `null`, true},
+		{"message without recipient", HoBomMessage, `{"type":"MAIL_MESSAGE","title":"t"}`, true},
+		{"valid log", HoBomLog, `[{"serviceType":"api","level":"INFO"}]`, false},
+		{"empty log batch", HoBomLog, `[]`, true},
+		{"malformed log", HoBomLog, `{"level":"INFO"}`, true},
+		{"unknown topic", "other", `garbage`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePayload(tt.topic, []byte(tt.payload)); (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSchema_RejectsUnknownFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		topic   string
+		payload string
+		wantErr bool
+	}{
+		{"valid message", HoBomMessage, `{"type":"PUSH_MESSAGE","title":"t","recipient":"r","sentAt":"2025-01-01T00:00:00Z"}`, false},
+		{"misspelled field", HoBomMessage, `{"type":"PUSH_MESSAGE","title":"t","recipent":"r"}`, true},
+		{"unknown field in a log", HoBomLog, `[{"serviceType":"api","level":"INFO","lvl":"x"}]`, true},
+		{"trailing value", HoBomLog, `[{"serviceType":"api","level":"INFO"}] []`, true},
+		{"unknown topic", "other", `{"any":"field"}`, false},
+		{"unknown topic without JSON", "other", `garbage`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSchema(tt.topic, []byte(tt.payload)); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMessagePoller_RoutesInvalidEventsToInvalidDLQ(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddMessage("valid", &outboxPb.MessagePayload{Title: "title", Recipient: "user-1"})
+	backend.AddMessage("no-recipient", &outboxPb.MessagePayload{Title: "title"})
+	backend.AddMessage("no-payload", nil)
+
+	store := &memoryDLQStore{data: make(map[string][]byte)}
+	pub := &recordingPublisher{}
+	NewMessagePoller(backend.Dial(t), pub, store, newMemoryAckJournal(), DefaultConfig()).Poll(context.Background())
+
+	if counts := pub.countByKey(); len(counts) != 1 || counts["valid"] != 1 {
+		t.Errorf("expected only the valid event published, got %v", counts)
+	}
+
+	for eventId, reason := range map[string]string{
+		"no-recipient": "recipient must not be empty",
+		"no-payload":   "payload must not be null",
+	} {
+		row, _ := backend.Row(eventId)
+		if row.Status != OutboxFailed || !strings.Contains(row.LastError, reason) {
+			t.Errorf("%s: expected FAILED with %q, got %s %q", eventId, reason, row.Status, row.LastError)
+		}
+
+		data, ok := store.data[HoBomInvalidMessageDLQPrefix+eventId]
+		if !ok {
+			t.Fatalf("%s: expected an entry under %s, got %v", eventId, HoBomInvalidMessageDLQPrefix, store.data)
+		}
+		entry, _ := DecodeDLQEntry(data)
+		if !strings.Contains(entry.Reason, reason) || entry.Topic != HoBomMessage {
+			t.Errorf("%s: unexpected entry %+v", eventId, entry)
+		}
+	}
+}
+
+func TestLogPoller_RoutesInvalidEventsToInvalidDLQ(t *testing.T) {
+	backend := outboxtest.NewBackend()
+	backend.AddLog("valid", &outboxLogPb.HoBomLogPayload{ServiceType: "api", Level: "INFO", TraceId: "trace-1"})
+	backend.AddLog("no-level", &outboxLogPb.HoBomLogPayload{ServiceType: "api", TraceId: "trace-1"})
+	backend.AddLog("no-payload", nil)
+
+	store := &memoryDLQStore{data: make(map[string][]byte)}
+	pub := &recordingPublisher{}
+	NewLogPoller(backend.Dial(t), pub, store, newMemoryAckJournal(), DefaultConfig()).Poll(context.Background())
+
+	if len(pub.events) != 1 {
+		t.Fatalf("expected 1 batch event, got %d", len(pub.events))
+	}
+	var published []HoBomLogMessageCommand
+	if err := json.Unmarshal(pub.events[0].Value, &published); err != nil || len(published) != 1 {
+		t.Errorf("expected the batch to hold only the valid log, got %s", pub.events[0].Value)
+	}
+
+	if row, _ := backend.Row("valid"); row.Status != OutboxSent {
+		t.Errorf("expected valid log SENT, got %s", row.Status)
+	}
+	for _, eventId := range []string{"no-level", "no-payload"} {
+		if row, _ := backend.Row(eventId); row.Status != OutboxFailed {
+			t.Errorf("%s: expected FAILED, got %s", eventId, row.Status)
+		}
+		if _, ok := store.data[HoBomInvalidLogDLQPrefix+eventId]; !ok {
+			t.Errorf("%s: expected an entry under %s", eventId, HoBomInvalidLogDLQPrefix)
+		}
+	}
+
+	entry, _ := DecodeDLQEntry(store.data[HoBomInvalidLogDLQPrefix+"no-level"])
+	if entry.Key != "trace-1" || ValidatePayload(HoBomLog, entry.Payload) == nil {
+		t.Errorf("expected the invalid single-entry batch keyed by trace, got %+v", entry)
+	}
+}
@@ -0,0 +1,80 @@
+package supervisor
+
+import (
+	"errors"
+	"net/http"
+
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"github.com/gin-gonic/gin"
+)
+
+// Service is the port for controlling the poll loops, implemented by
+// *poller.Supervisor.
+type Service interface {
+	Status() []poller.LoopStatus
+	Pause(name string) error
+	Resume(name string) error
+	Trigger(name string) error
+}
+
+type SupervisorHandler struct {
+	Service Service
+}
+
+func NewHandler(service Service) *SupervisorHandler {
+	return &SupervisorHandler{
+		Service: service,
+	}
+}
+
+// `GET` /pollers
+// 각 Poller 의 상태, 마지막 실행 시각, 마지막 에러, 처리한 Row 수를 가져오도록 한다.
+func (h *SupervisorHandler) GetPollers(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"items": h.Service.Status()})
+}
+
+// `POST` /pollers/:name/pause
+// Poller 를 일시 정지하도록 한다. 실행 중인 Poll 사이클은 끝까지 수행된다.
+func (h *SupervisorHandler) PausePoller(c *gin.Context) {
+	h.control(c, h.Service.Pause, http.StatusOK, "Poller paused")
+}
+
+// `POST` /pollers/:name/resume
+// 일시 정지된 Poller 를 재개하고, 즉시 Poll 사이클을 수행하도록 한다.
+func (h *SupervisorHandler) ResumePoller(c *gin.Context) {
+	h.control(c, h.Service.Resume, http.StatusOK, "Poller resumed")
+}
+
+// `POST` /pollers/:name/trigger
+// Poll 사이클을 한 번 즉시 수행하도록 요청한다. 일시 정지 중인 Poller 도 수행된다.
+func (h *SupervisorHandler) TriggerPoller(c *gin.Context) {
+	h.control(c, h.Service.Trigger, http.StatusAccepted, "Poll cycle triggered")
+}
+
+func (h *SupervisorHandler) control(c *gin.Context, action func(string) error, status int, message string) {
+	name := c.Param("name")
+
+	if err := action(name); err != nil {
+		c.Error(err)
+		switch {
+		case errors.Is(err, poller.ErrUnknownPoller):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, poller.ErrTriggerPending):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(status, gin.H{"message": message, "item": h.status(name)})
+}
+
+func (h *SupervisorHandler) status(name string) poller.LoopStatus {
+	for _, s := range h.Service.Status() {
+		if s.Name == name {
+			return s
+		}
+	}
+	return poller.LoopStatus{Name: name}
+}
@@ -0,0 +1,26 @@
+package supervisor
+
+import (
+	"github.com/HoBom-s/hobom-event-processor/internal/audit"
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	poller "github.com/HoBom-s/hobom-event-processor/internal/poller"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the poller routes. Reading their status requires
+// the read permission; pausing, resuming and triggering them requires write
+// and is recorded in the audit log.
+func RegisterRoutes(router *gin.Engine, guard *auth.Guard, auditor *audit.AuditService, service Service) {
+	handler := NewHandler(service)
+
+	pollers := router.Group(poller.HoBomEventProcessorInternalApiPrefix + "/pollers")
+	{
+		read := guard.Require(auth.PermissionRead)
+		write := guard.Require(auth.PermissionWrite)
+
+		pollers.GET("", read, handler.GetPollers)
+		pollers.POST("/:name/pause", auditor.Track(audit.ActionPollerPause, audit.Param("name")), write, handler.PausePoller)
+		pollers.POST("/:name/resume", auditor.Track(audit.ActionPollerResume, audit.Param("name")), write, handler.ResumePoller)
+		pollers.POST("/:name/trigger", auditor.Track(audit.ActionPollerTrigger, audit.Param("name")), write, handler.TriggerPoller)
+	}
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+)
+
+// WithBearerToken authenticates every request with a static token
+// (AUTH_TOKENS) or a JWT.
+func WithBearerToken(token string) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// WithHMACKey signs every request with a key of AUTH_HMAC_KEYS.
+func WithHMACKey(keyId, secret string) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		return auth.SignRequest(req, keyId, secret, time.Now())
+	})
+}
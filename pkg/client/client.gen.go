@@ -0,0 +1,3978 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes    = "bearerAuth.Scopes"
+	HmacKeyIdScopes     = "hmacKeyId.Scopes"
+	HmacSignatureScopes = "hmacSignature.Scopes"
+	HmacTimestampScopes = "hmacTimestamp.Scopes"
+)
+
+// Defines values for AuditRecordAction.
+const (
+	DlqDelete     AuditRecordAction = "dlq.delete"
+	DlqEdit       AuditRecordAction = "dlq.edit"
+	DlqExport     AuditRecordAction = "dlq.export"
+	DlqImport     AuditRecordAction = "dlq.import"
+	DlqPurge      AuditRecordAction = "dlq.purge"
+	DlqRetry      AuditRecordAction = "dlq.retry"
+	DlqRetryBatch AuditRecordAction = "dlq.retry_batch"
+	PollerPause   AuditRecordAction = "poller.pause"
+	PollerResume  AuditRecordAction = "poller.resume"
+	PollerTrigger AuditRecordAction = "poller.trigger"
+)
+
+// Defines values for AuditRecordOutcome.
+const (
+	Denied  AuditRecordOutcome = "denied"
+	Failure AuditRecordOutcome = "failure"
+	Partial AuditRecordOutcome = "partial"
+	Success AuditRecordOutcome = "success"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	Ok          HealthStatusStatus = "ok"
+	Unavailable HealthStatusStatus = "unavailable"
+)
+
+// Defines values for LoopStatusState.
+const (
+	Idle       LoopStatusState = "idle"
+	Paused     LoopStatusState = "paused"
+	Polling    LoopStatusState = "polling"
+	Restarting LoopStatusState = "restarting"
+	Stopped    LoopStatusState = "stopped"
+)
+
+// Defines values for PollerName.
+const (
+	PollerNameLog     PollerName = "log"
+	PollerNameMessage PollerName = "message"
+)
+
+// Aggregation defines model for Aggregation.
+type Aggregation struct {
+	// ByHour Counts by the start of the failure hour in UTC, oldest first, then unknown
+	ByHour []Bucket `json:"byHour"`
+
+	// ByPrefix Counts by key without the event ID, highest first
+	ByPrefix []Bucket `json:"byPrefix"`
+
+	// ByReason Counts by validation reason, else publish error, highest first
+	ByReason []Bucket `json:"byReason"`
+
+	// ByTopic Counts by topic, highest first
+	ByTopic []Bucket `json:"byTopic"`
+	Total   int      `json:"total"`
+}
+
+// AuditPage defines model for AuditPage.
+type AuditPage struct {
+	Items []AuditRecord `json:"items"`
+
+	// NextCursor Cursor of the next page, empty on the last one
+	NextCursor string `json:"nextCursor"`
+}
+
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	Action AuditRecordAction `json:"action"`
+
+	// Actor Empty when authentication failed
+	Actor      string             `json:"actor"`
+	AuthMethod *string            `json:"authMethod,omitempty"`
+	Error      *string            `json:"error,omitempty"`
+	Id         string             `json:"id"`
+	Outcome    AuditRecordOutcome `json:"outcome"`
+	RemoteAddr *string            `json:"remoteAddr,omitempty"`
+	RequestId  string             `json:"requestId"`
+	Status     int                `json:"status"`
+	Target     string             `json:"target"`
+	Time       time.Time          `json:"time"`
+}
+
+// AuditRecordAction defines model for AuditRecord.Action.
+type AuditRecordAction string
+
+// AuditRecordOutcome defines model for AuditRecord.Outcome.
+type AuditRecordOutcome string
+
+// Bucket defines model for Bucket.
+type Bucket struct {
+	Count int    `json:"count"`
+	Key   string `json:"key"`
+}
+
+// DLQEntry defines model for DLQEntry.
+type DLQEntry struct {
+	// Item The JSON payload, or the base64 value of a protobuf or Avro payload
+	Item     *interface{} `json:"item"`
+	Metadata struct {
+		// EditedAt When the current version was stored by an edit
+		EditedAt *time.Time `json:"editedAt,omitempty"`
+
+		// EditedBy Subject of the caller that stored the current version
+		EditedBy *string `json:"editedBy,omitempty"`
+
+		// Error Why publishing the event failed
+		Error *string `json:"error,omitempty"`
+
+		// FailedAt When the event failed. Missing on entries saved before it was recorded.
+		FailedAt *time.Time   `json:"failedAt,omitempty"`
+		Headers  *[]DLQHeader `json:"headers"`
+
+		// Key Kafka key
+		Key string `json:"key"`
+
+		// Reason Why validation rejected the event
+		Reason *string `json:"reason,omitempty"`
+		Topic  string  `json:"topic"`
+
+		// Version Number of edits of the payload; 0 is the event as it failed
+		Version *int `json:"version,omitempty"`
+	} `json:"metadata"`
+}
+
+// DLQHeader defines model for DLQHeader.
+type DLQHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DLQHistory defines model for DLQHistory.
+type DLQHistory struct {
+	Items []DLQRevision `json:"items"`
+	Key   string        `json:"key"`
+}
+
+// DLQRevision defines model for DLQRevision.
+type DLQRevision struct {
+	// EditedAt Missing for version 0
+	EditedAt *time.Time `json:"editedAt,omitempty"`
+	EditedBy *string    `json:"editedBy,omitempty"`
+
+	// Error Why publishing the version failed
+	Error *string `json:"error,omitempty"`
+
+	// Payload The JSON payload, null for binary entries
+	Payload *interface{} `json:"payload"`
+
+	// Reason Why validation rejected the version
+	Reason  *string `json:"reason,omitempty"`
+	Version int     `json:"version"`
+}
+
+// EditError defines model for EditError.
+type EditError struct {
+	Error string `json:"error"`
+
+	// Violations Rules of the event type broken by the payload
+	Violations *[]Violation `json:"violations,omitempty"`
+}
+
+// EditRequest defines model for EditRequest.
+type EditRequest struct {
+	// Payload The new JSON payload
+	Payload interface{} `json:"payload"`
+
+	// Version Version the edit is based on; the edit fails with 409 when the entry holds another one
+	Version *int `json:"version,omitempty"`
+}
+
+// EditResult defines model for EditResult.
+type EditResult struct {
+	// Error Why the replay failed
+	Error    *string `json:"error,omitempty"`
+	Key      string  `json:"key"`
+	Replayed bool    `json:"replayed"`
+
+	// Version Version stored by the edit
+	Version int `json:"version"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// ExportRecord defines model for ExportRecord.
+type ExportRecord struct {
+	// Entry A DLQ entry as the pollers store it. Either payload or value is set.
+	Entry      StoredDLQEntry `json:"entry"`
+	ExportedAt *time.Time     `json:"exportedAt,omitempty"`
+
+	// Key DLQ key, e.g. dlq:menu:event-abc
+	Key string `json:"key"`
+
+	// TtlSeconds Time the entry had left when exported; absent if it never expires
+	TtlSeconds *int64 `json:"ttlSeconds,omitempty"`
+}
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	Message    string             `json:"message"`
+	Status     HealthStatusStatus `json:"status"`
+	StatusCode int                `json:"statusCode"`
+}
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
+// ImportError defines model for ImportError.
+type ImportError struct {
+	Error    string   `json:"error"`
+	Imported []string `json:"imported"`
+	Skipped  []string `json:"skipped"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Imported []string `json:"imported"`
+
+	// Skipped Keys that already existed
+	Skipped []string `json:"skipped"`
+}
+
+// KeyList defines model for KeyList.
+type KeyList struct {
+	Items []string `json:"items"`
+}
+
+// LoopStatus defines model for LoopStatus.
+type LoopStatus struct {
+	ConsecutivePanics int             `json:"consecutivePanics"`
+	Cycles            int64           `json:"cycles"`
+	ItemsProcessed    int64           `json:"itemsProcessed"`
+	LastCycleAt       *time.Time      `json:"lastCycleAt,omitempty"`
+	LastError         *string         `json:"lastError,omitempty"`
+	LastPanic         *string         `json:"lastPanic,omitempty"`
+	LastPanicAt       *time.Time      `json:"lastPanicAt,omitempty"`
+	LastRunAt         *time.Time      `json:"lastRunAt,omitempty"`
+	Name              PollerName      `json:"name"`
+	Panics            int64           `json:"panics"`
+	Restarts          int64           `json:"restarts"`
+	State             LoopStatusState `json:"state"`
+}
+
+// LoopStatusState defines model for LoopStatus.State.
+type LoopStatusState string
+
+// Message defines model for Message.
+type Message struct {
+	Message string `json:"message"`
+}
+
+// PollerList defines model for PollerList.
+type PollerList struct {
+	Items []LoopStatus `json:"items"`
+}
+
+// PollerName defines model for PollerName.
+type PollerName string
+
+// PurgeError defines model for PurgeError.
+type PurgeError struct {
+	Deleted *[]string `json:"deleted"`
+	Error   string    `json:"error"`
+}
+
+// PurgeResult defines model for PurgeResult.
+type PurgeResult struct {
+	Deleted []string `json:"deleted"`
+}
+
+// RetryBatchRequest defines model for RetryBatchRequest.
+type RetryBatchRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// RetryBatchResult defines model for RetryBatchResult.
+type RetryBatchResult struct {
+	// Failed Error by key
+	Failed  map[string]string `json:"failed"`
+	Retried []string          `json:"retried"`
+}
+
+// SearchHit defines model for SearchHit.
+type SearchHit struct {
+	// Error Why publishing the event failed
+	Error *string `json:"error,omitempty"`
+
+	// FailedAt When the event failed, estimated from the TTL for older entries. Missing when unknown.
+	FailedAt *time.Time `json:"failedAt,omitempty"`
+
+	// Key DLQ key
+	Key string `json:"key"`
+
+	// Reason Why validation rejected the event
+	Reason *string `json:"reason,omitempty"`
+	Topic  string  `json:"topic"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Items []SearchHit `json:"items"`
+
+	// Matched Number of matching entries, also those beyond the limit
+	Matched int `json:"matched"`
+
+	// Scanned Number of entries read
+	Scanned int `json:"scanned"`
+}
+
+// StoredDLQEntry A DLQ entry as the pollers store it. Either payload or value is set.
+type StoredDLQEntry struct {
+	// EditedAt When the current version was stored by an edit
+	EditedAt *time.Time `json:"editedAt,omitempty"`
+
+	// EditedBy Subject of the caller that stored the current version
+	EditedBy *string `json:"editedBy,omitempty"`
+
+	// Error Why publishing the event failed
+	Error *string `json:"error,omitempty"`
+
+	// FailedAt When the event failed. Missing on entries saved before it was recorded.
+	FailedAt *time.Time   `json:"failedAt,omitempty"`
+	Headers  *[]DLQHeader `json:"headers,omitempty"`
+
+	// History Versions replaced by edits, oldest first
+	History *[]DLQRevision `json:"history,omitempty"`
+
+	// Key Kafka key
+	Key string `json:"key"`
+
+	// Payload The JSON payload
+	Payload *interface{} `json:"payload,omitempty"`
+
+	// Reason Why validation rejected the event
+	Reason *string `json:"reason,omitempty"`
+	Topic  string  `json:"topic"`
+
+	// Value The protobuf or Avro value
+	Value *[]byte `json:"value,omitempty"`
+
+	// Version Number of edits of the payload; 0 is the event as it failed
+	Version *int `json:"version,omitempty"`
+}
+
+// Violation defines model for Violation.
+type Violation struct {
+	// Field e.g. recipient or [1].level
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// DLQKey defines model for DLQKey.
+type DLQKey = string
+
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = Error
+
+// ListAuditParams defines parameters for ListAudit.
+type ListAuditParams struct {
+	// Actor Authenticated subject
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Action, e.g. dlq.retry
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Target Prefix of the target
+	Target *string `form:"target,omitempty" json:"target,omitempty"`
+
+	// Outcome success, partial, failure or denied
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// RequestId X-Request-Id of the request
+	RequestId *string `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// Since Oldest record time (RFC 3339)
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Newest record time (RFC 3339)
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Cursor nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Records per page, 100 by default and at most 1000
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PurgeDLQParams defines parameters for PurgeDLQ.
+type PurgeDLQParams struct {
+	// Prefix Prefix of the keys to delete; must start with dlq:.
+	Prefix string `form:"prefix" json:"prefix"`
+}
+
+// ListDLQParams defines parameters for ListDLQ.
+type ListDLQParams struct {
+	// Prefix Only keys starting with the prefix, e.g. dlq:log:. All dlq:* keys when empty.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// ExportDLQParams defines parameters for ExportDLQ.
+type ExportDLQParams struct {
+	// Prefix Only keys starting with the prefix, e.g. dlq:menu:. All dlq:* keys when empty and no key is given.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Key Keys to export instead of a prefix
+	Key *[]string `form:"key,omitempty" json:"key,omitempty"`
+}
+
+// ImportDLQParams defines parameters for ImportDLQ.
+type ImportDLQParams struct {
+	// Overwrite Replace entries that already exist instead of skipping them
+	Overwrite *bool `form:"overwrite,omitempty" json:"overwrite,omitempty"`
+
+	// Ttl Expiry of entries exported without a TTL, as a Go duration, e.g. 24h. 72h by default.
+	Ttl *string `form:"ttl,omitempty" json:"ttl,omitempty"`
+}
+
+// SearchDLQParams defines parameters for SearchDLQ.
+type SearchDLQParams struct {
+	// Prefix Only keys starting with the prefix, e.g. dlq:menu:. All dlq:* keys when empty.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Topic Only entries of the topic
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+
+	// Recipient Only messages sent to the recipient
+	Recipient *string `form:"recipient,omitempty" json:"recipient,omitempty"`
+
+	// TraceId Only log batches holding a log with the trace ID
+	TraceId *string `form:"traceId,omitempty" json:"traceId,omitempty"`
+
+	// ServiceType Only log batches holding a log of the service type
+	ServiceType *string `form:"serviceType,omitempty" json:"serviceType,omitempty"`
+
+	// Error Only entries whose validation reason or publish error contains the text, case-insensitively
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+
+	// Since Only entries that failed at or after the time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only entries that failed at or before the time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of entries returned, at most 1000
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AggregateDLQParams defines parameters for AggregateDLQ.
+type AggregateDLQParams struct {
+	// Prefix Only keys starting with the prefix, e.g. dlq:menu:. All dlq:* keys when empty.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Topic Only entries of the topic
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+
+	// Recipient Only messages sent to the recipient
+	Recipient *string `form:"recipient,omitempty" json:"recipient,omitempty"`
+
+	// TraceId Only log batches holding a log with the trace ID
+	TraceId *string `form:"traceId,omitempty" json:"traceId,omitempty"`
+
+	// ServiceType Only log batches holding a log of the service type
+	ServiceType *string `form:"serviceType,omitempty" json:"serviceType,omitempty"`
+
+	// Error Only entries whose validation reason or publish error contains the text, case-insensitively
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+
+	// Since Only entries that failed at or after the time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only entries that failed at or before the time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// EditDLQParams defines parameters for EditDLQ.
+type EditDLQParams struct {
+	// Replay Replay the edited entry right away
+	Replay *bool `form:"replay,omitempty" json:"replay,omitempty"`
+}
+
+// RetryDLQBatchJSONRequestBody defines body for RetryDLQBatch for application/json ContentType.
+type RetryDLQBatchJSONRequestBody = RetryBatchRequest
+
+// EditDLQJSONRequestBody defines body for EditDLQ for application/json ContentType.
+type EditDLQJSONRequestBody = EditRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAudit request
+	ListAudit(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurgeDLQ request
+	PurgeDLQ(ctx context.Context, params *PurgeDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDLQ request
+	ListDLQ(ctx context.Context, params *ListDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportDLQ request
+	ExportDLQ(ctx context.Context, params *ExportDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportDLQWithBody request with any body
+	ImportDLQWithBody(ctx context.Context, params *ImportDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryDLQBatchWithBody request with any body
+	RetryDLQBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RetryDLQBatch(ctx context.Context, body RetryDLQBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryDLQ request
+	RetryDLQ(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchDLQ request
+	SearchDLQ(ctx context.Context, params *SearchDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AggregateDLQ request
+	AggregateDLQ(ctx context.Context, params *AggregateDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDLQ request
+	DeleteDLQ(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDLQ request
+	GetDLQ(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditDLQWithBody request with any body
+	EditDLQWithBody(ctx context.Context, key DLQKey, params *EditDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditDLQ(ctx context.Context, key DLQKey, params *EditDLQParams, body EditDLQJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDLQHistory request
+	GetDLQHistory(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPollers request
+	ListPollers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PausePoller request
+	PausePoller(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumePoller request
+	ResumePoller(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TriggerPoller request
+	TriggerPoller(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMetrics request
+	GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReady request
+	GetReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAudit(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PurgeDLQ(ctx context.Context, params *PurgeDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurgeDLQRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDLQ(ctx context.Context, params *ListDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDLQRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportDLQ(ctx context.Context, params *ExportDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDLQRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportDLQWithBody(ctx context.Context, params *ImportDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDLQRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryDLQBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryDLQBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryDLQBatch(ctx context.Context, body RetryDLQBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryDLQBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryDLQ(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryDLQRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchDLQ(ctx context.Context, params *SearchDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDLQRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AggregateDLQ(ctx context.Context, params *AggregateDLQParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAggregateDLQRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDLQ(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDLQRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDLQ(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDLQRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditDLQWithBody(ctx context.Context, key DLQKey, params *EditDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditDLQRequestWithBody(c.Server, key, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditDLQ(ctx context.Context, key DLQKey, params *EditDLQParams, body EditDLQJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditDLQRequest(c.Server, key, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDLQHistory(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDLQHistoryRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPollers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPollersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PausePoller(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPausePollerRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResumePoller(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumePollerRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TriggerPoller(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerPollerRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMetricsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAuditRequest generates requests for ListAudit
+func NewListAuditRequest(server string, params *ListAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Target != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target", runtime.ParamLocationQuery, *params.Target); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Outcome != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "outcome", runtime.ParamLocationQuery, *params.Outcome); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RequestId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "requestId", runtime.ParamLocationQuery, *params.RequestId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPurgeDLQRequest generates requests for PurgeDLQ
+func NewPurgeDLQRequest(server string, params *PurgeDLQParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, params.Prefix); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDLQRequest generates requests for ListDLQ
+func NewListDLQRequest(server string, params *ListDLQParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportDLQRequest generates requests for ExportDLQ
+func NewExportDLQRequest(server string, params *ExportDLQParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Key != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportDLQRequestWithBody generates requests for ImportDLQ with any type of body
+func NewImportDLQRequestWithBody(server string, params *ImportDLQParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Overwrite != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overwrite", runtime.ParamLocationQuery, *params.Overwrite); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Ttl != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ttl", runtime.ParamLocationQuery, *params.Ttl); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRetryDLQBatchRequest calls the generic RetryDLQBatch builder with application/json body
+func NewRetryDLQBatchRequest(server string, body RetryDLQBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRetryDLQBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRetryDLQBatchRequestWithBody generates requests for RetryDLQBatch with any type of body
+func NewRetryDLQBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/retry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRetryDLQRequest generates requests for RetryDLQ
+func NewRetryDLQRequest(server string, key DLQKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/retry/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchDLQRequest generates requests for SearchDLQ
+func NewSearchDLQRequest(server string, params *SearchDLQParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Topic != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "topic", runtime.ParamLocationQuery, *params.Topic); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Recipient != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recipient", runtime.ParamLocationQuery, *params.Recipient); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TraceId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "traceId", runtime.ParamLocationQuery, *params.TraceId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServiceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "serviceType", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAggregateDLQRequest generates requests for AggregateDLQ
+func NewAggregateDLQRequest(server string, params *AggregateDLQParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Topic != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "topic", runtime.ParamLocationQuery, *params.Topic); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Recipient != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recipient", runtime.ParamLocationQuery, *params.Recipient); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TraceId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "traceId", runtime.ParamLocationQuery, *params.TraceId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServiceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "serviceType", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteDLQRequest generates requests for DeleteDLQ
+func NewDeleteDLQRequest(server string, key DLQKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDLQRequest generates requests for GetDLQ
+func NewGetDLQRequest(server string, key DLQKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditDLQRequest calls the generic EditDLQ builder with application/json body
+func NewEditDLQRequest(server string, key DLQKey, params *EditDLQParams, body EditDLQJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditDLQRequestWithBody(server, key, params, "application/json", bodyReader)
+}
+
+// NewEditDLQRequestWithBody generates requests for EditDLQ with any type of body
+func NewEditDLQRequestWithBody(server string, key DLQKey, params *EditDLQParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Replay != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "replay", runtime.ParamLocationQuery, *params.Replay); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDLQHistoryRequest generates requests for GetDLQHistory
+func NewGetDLQHistoryRequest(server string, key DLQKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/dlq/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPollersRequest generates requests for ListPollers
+func NewListPollersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/pollers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPausePollerRequest generates requests for PausePoller
+func NewPausePollerRequest(server string, name PollerName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/pollers/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResumePollerRequest generates requests for ResumePoller
+func NewResumePollerRequest(server string, name PollerName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/pollers/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTriggerPollerRequest generates requests for TriggerPoller
+func NewTriggerPollerRequest(server string, name PollerName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hobom-event-processor/internal/api/v1/pollers/%s/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMetricsRequest generates requests for GetMetrics
+func NewGetMetricsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReadyRequest generates requests for GetReady
+func NewGetReadyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// ListAuditWithResponse request
+	ListAuditWithResponse(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*ListAuditResponse, error)
+
+	// PurgeDLQWithResponse request
+	PurgeDLQWithResponse(ctx context.Context, params *PurgeDLQParams, reqEditors ...RequestEditorFn) (*PurgeDLQResponse, error)
+
+	// ListDLQWithResponse request
+	ListDLQWithResponse(ctx context.Context, params *ListDLQParams, reqEditors ...RequestEditorFn) (*ListDLQResponse, error)
+
+	// ExportDLQWithResponse request
+	ExportDLQWithResponse(ctx context.Context, params *ExportDLQParams, reqEditors ...RequestEditorFn) (*ExportDLQResponse, error)
+
+	// ImportDLQWithBodyWithResponse request with any body
+	ImportDLQWithBodyWithResponse(ctx context.Context, params *ImportDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDLQResponse, error)
+
+	// RetryDLQBatchWithBodyWithResponse request with any body
+	RetryDLQBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetryDLQBatchResponse, error)
+
+	RetryDLQBatchWithResponse(ctx context.Context, body RetryDLQBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*RetryDLQBatchResponse, error)
+
+	// RetryDLQWithResponse request
+	RetryDLQWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*RetryDLQResponse, error)
+
+	// SearchDLQWithResponse request
+	SearchDLQWithResponse(ctx context.Context, params *SearchDLQParams, reqEditors ...RequestEditorFn) (*SearchDLQResponse, error)
+
+	// AggregateDLQWithResponse request
+	AggregateDLQWithResponse(ctx context.Context, params *AggregateDLQParams, reqEditors ...RequestEditorFn) (*AggregateDLQResponse, error)
+
+	// DeleteDLQWithResponse request
+	DeleteDLQWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*DeleteDLQResponse, error)
+
+	// GetDLQWithResponse request
+	GetDLQWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*GetDLQResponse, error)
+
+	// EditDLQWithBodyWithResponse request with any body
+	EditDLQWithBodyWithResponse(ctx context.Context, key DLQKey, params *EditDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditDLQResponse, error)
+
+	EditDLQWithResponse(ctx context.Context, key DLQKey, params *EditDLQParams, body EditDLQJSONRequestBody, reqEditors ...RequestEditorFn) (*EditDLQResponse, error)
+
+	// GetDLQHistoryWithResponse request
+	GetDLQHistoryWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*GetDLQHistoryResponse, error)
+
+	// ListPollersWithResponse request
+	ListPollersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPollersResponse, error)
+
+	// PausePollerWithResponse request
+	PausePollerWithResponse(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*PausePollerResponse, error)
+
+	// ResumePollerWithResponse request
+	ResumePollerWithResponse(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*ResumePollerResponse, error)
+
+	// TriggerPollerWithResponse request
+	TriggerPollerWithResponse(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*TriggerPollerResponse, error)
+
+	// GetMetricsWithResponse request
+	GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+
+	// GetReadyWithResponse request
+	GetReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadyResponse, error)
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditPage
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurgeDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurgeResult
+	JSON400      *PurgeError
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *PurgeError
+}
+
+// Status returns HTTPResponse.Status
+func (r PurgeDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurgeDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KeyList
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+	JSON400      *ImportError
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *ImportError
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryDLQBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetryBatchResult
+	JSON207      *RetryBatchResult
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryDLQBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryDLQBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResult
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AggregateDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Aggregation
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AggregateDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AggregateDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DLQEntry
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditDLQResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EditResult
+	JSON400      *EditError
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *EditResult
+}
+
+// Status returns HTTPResponse.Status
+func (r EditDLQResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditDLQResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDLQHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DLQHistory
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDLQHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDLQHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPollersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PollerList
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPollersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPollersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PausePollerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PausePollerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PausePollerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumePollerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumePollerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumePollerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TriggerPollerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Message
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TriggerPollerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TriggerPollerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReadyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+	JSON503      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// ListAuditWithResponse request returning *ListAuditResponse
+func (c *ClientWithResponses) ListAuditWithResponse(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*ListAuditResponse, error) {
+	rsp, err := c.ListAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditResponse(rsp)
+}
+
+// PurgeDLQWithResponse request returning *PurgeDLQResponse
+func (c *ClientWithResponses) PurgeDLQWithResponse(ctx context.Context, params *PurgeDLQParams, reqEditors ...RequestEditorFn) (*PurgeDLQResponse, error) {
+	rsp, err := c.PurgeDLQ(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeDLQResponse(rsp)
+}
+
+// ListDLQWithResponse request returning *ListDLQResponse
+func (c *ClientWithResponses) ListDLQWithResponse(ctx context.Context, params *ListDLQParams, reqEditors ...RequestEditorFn) (*ListDLQResponse, error) {
+	rsp, err := c.ListDLQ(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDLQResponse(rsp)
+}
+
+// ExportDLQWithResponse request returning *ExportDLQResponse
+func (c *ClientWithResponses) ExportDLQWithResponse(ctx context.Context, params *ExportDLQParams, reqEditors ...RequestEditorFn) (*ExportDLQResponse, error) {
+	rsp, err := c.ExportDLQ(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDLQResponse(rsp)
+}
+
+// ImportDLQWithBodyWithResponse request with arbitrary body returning *ImportDLQResponse
+func (c *ClientWithResponses) ImportDLQWithBodyWithResponse(ctx context.Context, params *ImportDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDLQResponse, error) {
+	rsp, err := c.ImportDLQWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDLQResponse(rsp)
+}
+
+// RetryDLQBatchWithBodyWithResponse request with arbitrary body returning *RetryDLQBatchResponse
+func (c *ClientWithResponses) RetryDLQBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetryDLQBatchResponse, error) {
+	rsp, err := c.RetryDLQBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryDLQBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) RetryDLQBatchWithResponse(ctx context.Context, body RetryDLQBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*RetryDLQBatchResponse, error) {
+	rsp, err := c.RetryDLQBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryDLQBatchResponse(rsp)
+}
+
+// RetryDLQWithResponse request returning *RetryDLQResponse
+func (c *ClientWithResponses) RetryDLQWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*RetryDLQResponse, error) {
+	rsp, err := c.RetryDLQ(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryDLQResponse(rsp)
+}
+
+// SearchDLQWithResponse request returning *SearchDLQResponse
+func (c *ClientWithResponses) SearchDLQWithResponse(ctx context.Context, params *SearchDLQParams, reqEditors ...RequestEditorFn) (*SearchDLQResponse, error) {
+	rsp, err := c.SearchDLQ(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDLQResponse(rsp)
+}
+
+// AggregateDLQWithResponse request returning *AggregateDLQResponse
+func (c *ClientWithResponses) AggregateDLQWithResponse(ctx context.Context, params *AggregateDLQParams, reqEditors ...RequestEditorFn) (*AggregateDLQResponse, error) {
+	rsp, err := c.AggregateDLQ(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAggregateDLQResponse(rsp)
+}
+
+// DeleteDLQWithResponse request returning *DeleteDLQResponse
+func (c *ClientWithResponses) DeleteDLQWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*DeleteDLQResponse, error) {
+	rsp, err := c.DeleteDLQ(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDLQResponse(rsp)
+}
+
+// GetDLQWithResponse request returning *GetDLQResponse
+func (c *ClientWithResponses) GetDLQWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*GetDLQResponse, error) {
+	rsp, err := c.GetDLQ(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDLQResponse(rsp)
+}
+
+// EditDLQWithBodyWithResponse request with arbitrary body returning *EditDLQResponse
+func (c *ClientWithResponses) EditDLQWithBodyWithResponse(ctx context.Context, key DLQKey, params *EditDLQParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditDLQResponse, error) {
+	rsp, err := c.EditDLQWithBody(ctx, key, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditDLQResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditDLQWithResponse(ctx context.Context, key DLQKey, params *EditDLQParams, body EditDLQJSONRequestBody, reqEditors ...RequestEditorFn) (*EditDLQResponse, error) {
+	rsp, err := c.EditDLQ(ctx, key, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditDLQResponse(rsp)
+}
+
+// GetDLQHistoryWithResponse request returning *GetDLQHistoryResponse
+func (c *ClientWithResponses) GetDLQHistoryWithResponse(ctx context.Context, key DLQKey, reqEditors ...RequestEditorFn) (*GetDLQHistoryResponse, error) {
+	rsp, err := c.GetDLQHistory(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDLQHistoryResponse(rsp)
+}
+
+// ListPollersWithResponse request returning *ListPollersResponse
+func (c *ClientWithResponses) ListPollersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPollersResponse, error) {
+	rsp, err := c.ListPollers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPollersResponse(rsp)
+}
+
+// PausePollerWithResponse request returning *PausePollerResponse
+func (c *ClientWithResponses) PausePollerWithResponse(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*PausePollerResponse, error) {
+	rsp, err := c.PausePoller(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePausePollerResponse(rsp)
+}
+
+// ResumePollerWithResponse request returning *ResumePollerResponse
+func (c *ClientWithResponses) ResumePollerWithResponse(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*ResumePollerResponse, error) {
+	rsp, err := c.ResumePoller(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumePollerResponse(rsp)
+}
+
+// TriggerPollerWithResponse request returning *TriggerPollerResponse
+func (c *ClientWithResponses) TriggerPollerWithResponse(ctx context.Context, name PollerName, reqEditors ...RequestEditorFn) (*TriggerPollerResponse, error) {
+	rsp, err := c.TriggerPoller(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerPollerResponse(rsp)
+}
+
+// GetMetricsWithResponse request returning *GetMetricsResponse
+func (c *ClientWithResponses) GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error) {
+	rsp, err := c.GetMetrics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMetricsResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// GetReadyWithResponse request returning *GetReadyResponse
+func (c *ClientWithResponses) GetReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadyResponse, error) {
+	rsp, err := c.GetReady(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadyResponse(rsp)
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAuditResponse parses an HTTP response from a ListAuditWithResponse call
+func ParseListAuditResponse(rsp *http.Response) (*ListAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePurgeDLQResponse parses an HTTP response from a PurgeDLQWithResponse call
+func ParsePurgeDLQResponse(rsp *http.Response) (*PurgeDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PurgeDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurgeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest PurgeError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest PurgeError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDLQResponse parses an HTTP response from a ListDLQWithResponse call
+func ParseListDLQResponse(rsp *http.Response) (*ListDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KeyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportDLQResponse parses an HTTP response from a ExportDLQWithResponse call
+func ParseExportDLQResponse(rsp *http.Response) (*ExportDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportDLQResponse parses an HTTP response from a ImportDLQWithResponse call
+func ParseImportDLQResponse(rsp *http.Response) (*ImportDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ImportError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ImportError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetryDLQBatchResponse parses an HTTP response from a RetryDLQBatchWithResponse call
+func ParseRetryDLQBatchResponse(rsp *http.Response) (*RetryDLQBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryDLQBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetryBatchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RetryBatchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetryDLQResponse parses an HTTP response from a RetryDLQWithResponse call
+func ParseRetryDLQResponse(rsp *http.Response) (*RetryDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchDLQResponse parses an HTTP response from a SearchDLQWithResponse call
+func ParseSearchDLQResponse(rsp *http.Response) (*SearchDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAggregateDLQResponse parses an HTTP response from a AggregateDLQWithResponse call
+func ParseAggregateDLQResponse(rsp *http.Response) (*AggregateDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AggregateDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Aggregation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDLQResponse parses an HTTP response from a DeleteDLQWithResponse call
+func ParseDeleteDLQResponse(rsp *http.Response) (*DeleteDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDLQResponse parses an HTTP response from a GetDLQWithResponse call
+func ParseGetDLQResponse(rsp *http.Response) (*GetDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DLQEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditDLQResponse parses an HTTP response from a EditDLQWithResponse call
+func ParseEditDLQResponse(rsp *http.Response) (*EditDLQResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditDLQResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EditResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest EditError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest EditResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDLQHistoryResponse parses an HTTP response from a GetDLQHistoryWithResponse call
+func ParseGetDLQHistoryResponse(rsp *http.Response) (*GetDLQHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDLQHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DLQHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPollersResponse parses an HTTP response from a ListPollersWithResponse call
+func ParseListPollersResponse(rsp *http.Response) (*ListPollersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPollersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PollerList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePausePollerResponse parses an HTTP response from a PausePollerWithResponse call
+func ParsePausePollerResponse(rsp *http.Response) (*PausePollerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PausePollerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumePollerResponse parses an HTTP response from a ResumePollerWithResponse call
+func ParseResumePollerResponse(rsp *http.Response) (*ResumePollerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumePollerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTriggerPollerResponse parses an HTTP response from a TriggerPollerWithResponse call
+func ParseTriggerPollerResponse(rsp *http.Response) (*TriggerPollerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TriggerPollerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMetricsResponse parses an HTTP response from a GetMetricsWithResponse call
+func ParseGetMetricsResponse(rsp *http.Response) (*GetMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReadyResponse parses an HTTP response from a GetReadyWithResponse call
+func ParseGetReadyResponse(rsp *http.Response) (*GetReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
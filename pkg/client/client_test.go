@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/HoBom-s/hobom-event-processor/internal/auth"
+	"github.com/gin-gonic/gin"
+)
+
+const prefix = "/hobom-event-processor/internal/api/v1"
+
+// newServer serves a DLQ entry and batch replays behind a Guard accepting
+// the token "read-token" and the HMAC key "cli".
+func newServer(t *testing.T) string {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	guard := auth.NewGuardWith(
+		auth.NewTokenAuthenticator([]auth.Credential{{Subject: "grafana", Role: auth.RoleReader, Secret: "read-token"}}),
+		auth.NewHMACAuthenticator([]auth.Credential{{Subject: "cli", Role: auth.RoleOperator, Secret: "s3cr3t"}}, time.Minute, 1<<20),
+	)
+
+	router := gin.New()
+	router.GET(prefix+"/dlq/:key", guard.Require(auth.PermissionRead), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"item": gin.H{"title": "hello"}, "metadata": gin.H{"key": "event-abc", "topic": "hobom.messages", "reason": c.Param("key")}})
+	})
+	router.POST(prefix+"/dlq/retry", guard.Require(auth.PermissionWrite), func(c *gin.Context) {
+		var req RetryBatchRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusMultiStatus, gin.H{"retried": req.Keys[:1], "failed": gin.H{req.Keys[1]: "kafka down"}})
+	})
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestClient_BearerToken(t *testing.T) {
+	c, err := NewClientWithResponses(newServer(t), WithBearerToken("read-token"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res, err := c.GetDLQWithResponse(context.Background(), "dlq:menu:event-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.JSON200 == nil || res.JSON200.Metadata.Topic != "hobom.messages" || *res.JSON200.Metadata.Reason != "dlq:menu:event-abc" {
+		t.Errorf("expected the entry of the key, got %d %s", res.StatusCode(), res.Body)
+	}
+
+	retry, err := c.RetryDLQBatchWithResponse(context.Background(), RetryDLQBatchJSONRequestBody{Keys: []string{"dlq:log:1", "dlq:log:2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if retry.JSON403 == nil {
+		t.Errorf("expected a reader denied replays, got %d %s", retry.StatusCode(), retry.Body)
+	}
+}
+
+func TestClient_HMACKey(t *testing.T) {
+	c, err := NewClientWithResponses(newServer(t), WithHMACKey("cli", "s3cr3t"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res, err := c.RetryDLQBatchWithResponse(context.Background(), RetryDLQBatchJSONRequestBody{Keys: []string{"dlq:log:1", "dlq:log:2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.JSON207 == nil || len(res.JSON207.Retried) != 1 || res.JSON207.Failed["dlq:log:2"] != "kafka down" {
+		t.Errorf("expected the signed batch replay accepted, got %d %s", res.StatusCode(), res.Body)
+	}
+}
@@ -0,0 +1,6 @@
+// Package client is the Go client of the management API, generated from
+// internal/openapi/openapi.json. Regenerate it with `make openapi-client`
+// after changing the document.
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config oapi-codegen.yaml ../../internal/openapi/openapi.json